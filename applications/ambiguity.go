@@ -0,0 +1,41 @@
+package applications
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/trees"
+)
+
+type ambiguity struct {
+	token    grammars.Token
+	position trees.Position
+	lines    []trees.Line
+}
+
+func createAmbiguity(
+	token grammars.Token,
+	position trees.Position,
+	lines []trees.Line,
+) Ambiguity {
+	out := ambiguity{
+		token:    token,
+		position: position,
+		lines:    lines,
+	}
+
+	return &out
+}
+
+// Token returns the ambiguous token
+func (obj *ambiguity) Token() grammars.Token {
+	return obj.token
+}
+
+// Position returns the span matched by every line
+func (obj *ambiguity) Position() trees.Position {
+	return obj.position
+}
+
+// Lines returns the lines that matched the same span, ordered by index
+func (obj *ambiguity) Lines() []trees.Line {
+	return obj.lines
+}
@@ -0,0 +1,72 @@
+package analyses
+
+import (
+	"errors"
+	"fmt"
+
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+type analysis struct {
+	list  []Token
+	mp    map[string]Token
+	names map[string]grammars.Token
+}
+
+func createAnalysis(
+	list []Token,
+	mp map[string]Token,
+	names map[string]grammars.Token,
+) Analysis {
+	out := analysis{
+		list:  list,
+		mp:    mp,
+		names: names,
+	}
+
+	return &out
+}
+
+// List returns the tokens
+func (obj *analysis) List() []Token {
+	return obj.list
+}
+
+// Fetch fetches the analysis of a token
+func (obj *analysis) Fetch(token grammars.Token) (Token, error) {
+	if ins, ok := obj.mp[string(token.Hash())]; ok {
+		return ins, nil
+	}
+
+	str := fmt.Sprintf("the token (hash: %s) is not part of the analyzed grammar", token.Hash().String())
+	return nil, errors.New(str)
+}
+
+// IsNullable returns true if the element content can match an empty input, false otherwise
+func (obj *analysis) IsNullable(content grammars.ElementContent) bool {
+	if content.IsValue() {
+		return len(content.Value()) <= 0
+	}
+
+	if content.IsGrammar() {
+		return obj.isNullable(content.Grammar().Root())
+	}
+
+	if content.IsRecursive() {
+		token, ok := obj.names[content.Recursive()]
+		return ok && obj.isNullable(token)
+	}
+
+	// an everything instance matches at least one byte before its exception:
+	instance := content.Instance()
+	if instance.IsEverything() {
+		return false
+	}
+
+	return obj.isNullable(instance.Token())
+}
+
+func (obj *analysis) isNullable(token grammars.Token) bool {
+	ins, ok := obj.mp[string(token.Hash())]
+	return ok && ins.IsNullable()
+}
@@ -0,0 +1,285 @@
+package analyses
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+type application struct {
+}
+
+type analyzing struct {
+	tokens     []grammars.Token
+	names      map[string]grammars.Token
+	exceptions map[string]set
+	nullables  map[string]bool
+	firsts     map[string]*set
+	follows    map[string]*set
+	ends       map[string]bool
+}
+
+func createApplication() Application {
+	out := application{}
+	return &out
+}
+
+// Analyze computes the nullability, the first and the follow bytes of every token of the grammar
+func (app *application) Analyze(grammar grammars.Grammar) Analysis {
+	state := &analyzing{
+		tokens:     []grammars.Token{},
+		names:      map[string]grammars.Token{},
+		exceptions: map[string]set{},
+		nullables:  map[string]bool{},
+		firsts:     map[string]*set{},
+		follows:    map[string]*set{},
+		ends:       map[string]bool{},
+	}
+
+	app.grammar(state, grammar)
+	state.ends[string(grammar.Root().Hash())] = true
+
+	// the first bytes of an everything instance exclude the first bytes of its exception, which are
+	// frozen during a pass, so the passes are repeated until the exceptions are stable:
+	for round := 0; round <= len(state.tokens); round++ {
+		state.nullables = map[string]bool{}
+		for _, oneToken := range state.tokens {
+			state.firsts[string(oneToken.Hash())] = &set{}
+		}
+
+		isChanged := true
+		for isChanged {
+			isChanged = app.resolveFirsts(state)
+		}
+
+		isStable := true
+		for keyname, exception := range state.exceptions {
+			if exception == *state.firsts[keyname] {
+				continue
+			}
+
+			state.exceptions[keyname] = *state.firsts[keyname]
+			isStable = false
+		}
+
+		if isStable {
+			break
+		}
+	}
+
+	isChanged := true
+	for isChanged {
+		isChanged = app.resolveFollows(state)
+	}
+
+	list := []Token{}
+	mp := map[string]Token{}
+	for _, oneToken := range state.tokens {
+		keyname := string(oneToken.Hash())
+		ins := createToken(
+			oneToken,
+			state.nullables[keyname],
+			state.firsts[keyname].bytes(),
+			state.follows[keyname].bytes(),
+			state.ends[keyname],
+		)
+
+		list = append(list, ins)
+		mp[keyname] = ins
+	}
+
+	return createAnalysis(list, mp, state.names)
+}
+
+func (app *application) grammar(state *analyzing, grammar grammars.Grammar) {
+	app.token(state, grammar.Root())
+	if !grammar.HasChannels() {
+		return
+	}
+
+	for _, oneChannel := range grammar.Channels() {
+		app.token(state, oneChannel.Token())
+	}
+}
+
+func (app *application) token(state *analyzing, token grammars.Token) {
+	keyname := string(token.Hash())
+	if _, ok := state.firsts[keyname]; ok {
+		return
+	}
+
+	state.tokens = append(state.tokens, token)
+	state.firsts[keyname] = &set{}
+	state.follows[keyname] = &set{}
+	if token.HasName() {
+		state.names[token.Name()] = token
+	}
+
+	for _, oneLine := range token.Lines() {
+		for _, oneElement := range oneLine.Elements() {
+			content := oneElement.Content()
+			if content.IsGrammar() {
+				app.grammar(state, content.Grammar())
+				continue
+			}
+
+			if !content.IsInstance() {
+				continue
+			}
+
+			instance := content.Instance()
+			if instance.IsToken() {
+				app.token(state, instance.Token())
+				continue
+			}
+
+			everything := instance.Everything()
+			state.exceptions[string(everything.Exception().Hash())] = set{}
+			app.token(state, everything.Exception())
+			if everything.HasEscape() {
+				app.token(state, everything.Escape())
+			}
+		}
+	}
+}
+
+// resolveFirsts adds the first bytes and the nullability of the lines to their tokens, and returns true if a token changed, false otherwise
+func (app *application) resolveFirsts(state *analyzing) bool {
+	isChanged := false
+	for _, oneToken := range state.tokens {
+		keyname := string(oneToken.Hash())
+		for _, oneLine := range oneToken.Lines() {
+			first, isNullable := app.elements(state, oneLine.Elements())
+			if state.firsts[keyname].add(first) {
+				isChanged = true
+			}
+
+			if isNullable && !state.nullables[keyname] {
+				state.nullables[keyname] = true
+				isChanged = true
+			}
+		}
+	}
+
+	return isChanged
+}
+
+// resolveFollows adds the bytes that can follow each token of a line, and returns true if a token changed, false otherwise
+func (app *application) resolveFollows(state *analyzing) bool {
+	isChanged := false
+	for _, oneToken := range state.tokens {
+		keyname := string(oneToken.Hash())
+		for _, oneLine := range oneToken.Lines() {
+			elements := oneLine.Elements()
+			for idx, oneElement := range elements {
+				elementKeyname, ok := app.keyname(state, oneElement.Content())
+				if !ok {
+					continue
+				}
+
+				follow := state.follows[elementKeyname]
+				first, isNullable := app.elements(state, elements[idx+1:])
+				if follow.add(first) {
+					isChanged = true
+				}
+
+				if isNullable {
+					if follow.add(*state.follows[keyname]) {
+						isChanged = true
+					}
+
+					if state.ends[keyname] && !state.ends[elementKeyname] {
+						state.ends[elementKeyname] = true
+						isChanged = true
+					}
+				}
+
+				// a repeated element can be followed by itself:
+				cardinality := oneElement.Cardinality()
+				if !cardinality.HasMax() || *cardinality.Max() > 1 {
+					if follow.add(*state.firsts[elementKeyname]) {
+						isChanged = true
+					}
+				}
+			}
+		}
+	}
+
+	return isChanged
+}
+
+// elements returns the first bytes of a sequence of elements, and true if the sequence can match an empty input, false otherwise
+func (app *application) elements(state *analyzing, elements []grammars.Element) (set, bool) {
+	output := set{}
+	for _, oneElement := range elements {
+		cardinality := oneElement.Cardinality()
+		if cardinality.HasMax() && *cardinality.Max() <= 0 {
+			continue
+		}
+
+		first, isNullable := app.content(state, oneElement.Content())
+		output.add(first)
+		if cardinality.Min() > 0 && !isNullable {
+			return output, false
+		}
+	}
+
+	return output, true
+}
+
+// content returns the first bytes of an element content, and true if it can match an empty input, false otherwise
+func (app *application) content(state *analyzing, content grammars.ElementContent) (set, bool) {
+	if content.IsValue() {
+		output := set{}
+		value := content.Value()
+		if len(value) <= 0 {
+			return output, true
+		}
+
+		output[value[0]] = true
+		return output, false
+	}
+
+	if content.IsInstance() && content.Instance().IsEverything() {
+		// an everything instance starts with any byte that does not start its exception, or with its escape:
+		everything := content.Instance().Everything()
+		output := set{}
+		exception := state.exceptions[string(everything.Exception().Hash())]
+		for idx := range output {
+			output[idx] = !exception[idx]
+		}
+
+		if everything.HasEscape() {
+			output.add(*state.firsts[string(everything.Escape().Hash())])
+		}
+
+		return output, false
+	}
+
+	keyname, ok := app.keyname(state, content)
+	if !ok {
+		return set{}, false
+	}
+
+	return *state.firsts[keyname], state.nullables[keyname]
+}
+
+// keyname returns the keyname of the token matched by an element content, if any
+func (app *application) keyname(state *analyzing, content grammars.ElementContent) (string, bool) {
+	if content.IsGrammar() {
+		return string(content.Grammar().Root().Hash()), true
+	}
+
+	if content.IsRecursive() {
+		token, ok := state.names[content.Recursive()]
+		if !ok {
+			return "", false
+		}
+
+		return string(token.Hash()), true
+	}
+
+	if content.IsInstance() && content.Instance().IsToken() {
+		return string(content.Instance().Token().Hash()), true
+	}
+
+	return "", false
+}
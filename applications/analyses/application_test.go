@@ -0,0 +1,87 @@
+package analyses_test
+
+import (
+	"testing"
+
+	"github.com/steve-care-software/grammars/applications/analyses"
+	"github.com/steve-care-software/grammars/infrastructure/scripts"
+)
+
+func TestAnalysis_Success(t *testing.T) {
+	script := `
+		@list;
+
+		list: item+ semicolon;
+		item: number | text;
+		number: sign digit+;
+		sign: plus?;
+		digit: one | two;
+		text: quote content quote;
+		content: #quote;
+
+		one: 49;
+		two: 50;
+		plus: 43;
+		quote: 34;
+		semicolon: 59;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := map[string]struct {
+		isNullable      bool
+		first           string
+		follow          string
+		isFollowedByEnd bool
+	}{
+		"list":   {isNullable: false, first: `"+12`, follow: "", isFollowedByEnd: true},
+		"item":   {isNullable: false, first: `"+12`, follow: `"+12;`, isFollowedByEnd: false},
+		"number": {isNullable: false, first: "+12", follow: `"+12;`, isFollowedByEnd: false},
+		"sign":   {isNullable: true, first: "+", follow: "12", isFollowedByEnd: false},
+		"digit":  {isNullable: false, first: "12", follow: `"+12;`, isFollowedByEnd: false},
+		"text":   {isNullable: false, first: `"`, follow: `"+12;`, isFollowedByEnd: false},
+	}
+
+	analysis := analyses.NewApplication().Analyze(reference.Root())
+	for _, oneToken := range reference.Tokens().List() {
+		expectation, ok := expected[oneToken.Name()]
+		if !ok {
+			continue
+		}
+
+		ins, err := analysis.Fetch(oneToken.Reference())
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if ins.IsNullable() != expectation.isNullable || string(ins.First()) != expectation.first || string(ins.Follow()) != expectation.follow || ins.IsFollowedByEnd() != expectation.isFollowedByEnd {
+			t.Errorf("the token (name: %s) was expected to be (nullable: %t, first: %q, follow: %q, end: %t), returned (nullable: %t, first: %q, follow: %q, end: %t)", oneToken.Name(), expectation.isNullable, expectation.first, expectation.follow, expectation.isFollowedByEnd, ins.IsNullable(), ins.First(), ins.Follow(), ins.IsFollowedByEnd())
+			return
+		}
+
+		delete(expected, oneToken.Name())
+	}
+
+	if len(expected) > 0 {
+		t.Errorf("%d tokens were not analyzed", len(expected))
+		return
+	}
+
+	// the content of the text starts with any byte except the quote:
+	for _, oneToken := range reference.Tokens().List() {
+		if oneToken.Name() != "text" {
+			continue
+		}
+
+		content := oneToken.Reference().Lines()[0].Elements()[1].Content()
+		if analysis.IsNullable(content) {
+			t.Errorf("the everything instance was expected to NOT be nullable")
+			return
+		}
+	}
+}
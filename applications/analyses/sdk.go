@@ -0,0 +1,31 @@
+package analyses
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+// NewApplication creates a new analysis application
+func NewApplication() Application {
+	return createApplication()
+}
+
+// Application represents an analysis application
+type Application interface {
+	Analyze(grammar grammars.Grammar) Analysis
+}
+
+// Analysis represents the analysis of the tokens of a grammar, including the tokens of its external grammars
+type Analysis interface {
+	List() []Token
+	Fetch(token grammars.Token) (Token, error)
+	IsNullable(content grammars.ElementContent) bool
+}
+
+// Token represents the analysis of a token, ignoring the bytes matched by channels
+type Token interface {
+	Token() grammars.Token
+	IsNullable() bool
+	First() []byte
+	Follow() []byte
+	IsFollowedByEnd() bool
+}
@@ -0,0 +1,33 @@
+package analyses
+
+// set represents a set of bytes
+type set [256]bool
+
+// add adds the bytes of the other set, and returns true if the set changed, false otherwise
+func (obj *set) add(other set) bool {
+	isChanged := false
+	for idx, isPresent := range other {
+		if !isPresent || obj[idx] {
+			continue
+		}
+
+		obj[idx] = true
+		isChanged = true
+	}
+
+	return isChanged
+}
+
+// bytes returns the bytes of the set, in order
+func (obj *set) bytes() []byte {
+	output := []byte{}
+	for idx, isPresent := range obj {
+		if !isPresent {
+			continue
+		}
+
+		output = append(output, byte(idx))
+	}
+
+	return output
+}
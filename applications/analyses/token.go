@@ -0,0 +1,56 @@
+package analyses
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+type token struct {
+	token           grammars.Token
+	isNullable      bool
+	first           []byte
+	follow          []byte
+	isFollowedByEnd bool
+}
+
+func createToken(
+	ins grammars.Token,
+	isNullable bool,
+	first []byte,
+	follow []byte,
+	isFollowedByEnd bool,
+) Token {
+	out := token{
+		token:           ins,
+		isNullable:      isNullable,
+		first:           first,
+		follow:          follow,
+		isFollowedByEnd: isFollowedByEnd,
+	}
+
+	return &out
+}
+
+// Token returns the token
+func (obj *token) Token() grammars.Token {
+	return obj.token
+}
+
+// IsNullable returns true if the token can match an empty input, false otherwise
+func (obj *token) IsNullable() bool {
+	return obj.isNullable
+}
+
+// First returns the bytes that can start the token, in order
+func (obj *token) First() []byte {
+	return obj.first
+}
+
+// Follow returns the bytes that can follow the token, in order
+func (obj *token) Follow() []byte {
+	return obj.follow
+}
+
+// IsFollowedByEnd returns true if the end of the input can follow the token, false otherwise
+func (obj *token) IsFollowedByEnd() bool {
+	return obj.isFollowedByEnd
+}
@@ -2,8 +2,11 @@ package applications
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
+	"io"
+	"sort"
 
 	grammars "github.com/steve-care-software/grammars/domain"
 	"github.com/steve-care-software/grammars/domain/references"
@@ -20,11 +23,18 @@ type application struct {
 	treeElementBuilder        trees.ElementBuilder
 	treeContentBuilder        trees.ContentBuilder
 	treeValueBuilder          trees.ValueBuilder
+	treePositionBuilder       trees.PositionBuilder
 	coveragesBuilder          coverages.Builder
 	coverageBuilder           coverages.CoverageBuilder
 	coverageExecutionsBuilder coverages.ExecutionsBuilder
 	coverageExecutionBuilder  coverages.ExecutionBuilder
 	coverageResultBuilder     coverages.ResultBuilder
+	coverageTokenBuilder      coverages.TokenBuilder
+	coverageLineBuilder       coverages.LineBuilder
+	coverageElementBuilder    coverages.ElementBuilder
+	pMemoLimit                *uint
+	isLongestMatch            bool
+	isStrict                  bool
 }
 
 func createApplication(
@@ -36,11 +46,18 @@ func createApplication(
 	treeElementBuilder trees.ElementBuilder,
 	treeContentBuilder trees.ContentBuilder,
 	treeValueBuilder trees.ValueBuilder,
+	treePositionBuilder trees.PositionBuilder,
 	coveragesBuilder coverages.Builder,
 	coverageBuilder coverages.CoverageBuilder,
 	coverageExecutionsBuilder coverages.ExecutionsBuilder,
 	coverageExecutionBuilder coverages.ExecutionBuilder,
 	coverageResultBuilder coverages.ResultBuilder,
+	coverageTokenBuilder coverages.TokenBuilder,
+	coverageLineBuilder coverages.LineBuilder,
+	coverageElementBuilder coverages.ElementBuilder,
+	pMemoLimit *uint,
+	isLongestMatch bool,
+	isStrict bool,
 ) Application {
 	out := application{
 		grammarTokenBuilder:       grammarTokenBuilder,
@@ -51,11 +68,18 @@ func createApplication(
 		treeElementBuilder:        treeElementBuilder,
 		treeContentBuilder:        treeContentBuilder,
 		treeValueBuilder:          treeValueBuilder,
+		treePositionBuilder:       treePositionBuilder,
 		coveragesBuilder:          coveragesBuilder,
 		coverageBuilder:           coverageBuilder,
 		coverageExecutionsBuilder: coverageExecutionsBuilder,
 		coverageExecutionBuilder:  coverageExecutionBuilder,
 		coverageResultBuilder:     coverageResultBuilder,
+		coverageTokenBuilder:      coverageTokenBuilder,
+		coverageLineBuilder:       coverageLineBuilder,
+		coverageElementBuilder:    coverageElementBuilder,
+		pMemoLimit:                pMemoLimit,
+		isLongestMatch:            isLongestMatch,
+		isStrict:                  isStrict,
 	}
 
 	return &out
@@ -63,20 +87,225 @@ func createApplication(
 
 // Execute executes grammar on data
 func (app *application) Execute(grammar grammars.Grammar, values []byte) (trees.Tree, error) {
-	return app.grammar(grammar, false, []byte{}, values)
+	return app.execute(grammar, values, app.isStrict)
+}
+
+// ExecuteComplete executes grammar on data, and returns an error if the data is not entirely consumed
+func (app *application) ExecuteComplete(grammar grammars.Grammar, values []byte) (trees.Tree, error) {
+	return app.execute(grammar, values, true)
+}
+
+// ExecuteContext executes grammar on data, and stops once the context is done or one of the limits, if any, is exceeded
+func (app *application) ExecuteContext(ctx context.Context, grammar grammars.Grammar, values []byte, limits Limits) (trees.Tree, error) {
+	return app.executeWithBudget(grammar, values, app.isStrict, createBudget(ctx, limits))
+}
+
+func (app *application) execute(grammar grammars.Grammar, values []byte, isComplete bool) (trees.Tree, error) {
+	return app.executeWithBudget(grammar, values, isComplete, createBudget(context.Background(), nil))
+}
+
+func (app *application) executeWithBudget(grammar grammars.Grammar, values []byte, isComplete bool, budget *budget) (trees.Tree, error) {
+	execution := createExecution(values, app.pMemoLimit)
+	execution.budget = budget
+	return app.executeFrom(grammar, execution, isComplete)
+}
+
+// executeFrom executes the grammar on the input of the execution, that starts at its origin location, if any
+func (app *application) executeFrom(grammar grammars.Grammar, execution *execution, isComplete bool) (trees.Tree, error) {
+	tree, err := app.grammar(grammar, false, execution, []byte{}, execution.input)
+	if execution.budget.err != nil {
+		return nil, execution.budget.err
+	}
+
+	if err != nil {
+		return nil, app.parseError(execution, err)
+	}
+
+	if isComplete && tree.HasRemaining() {
+		return nil, app.remainingError(execution, tree.Remaining())
+	}
+
+	return tree, nil
+}
+
+// Stream returns a stream that repeatedly parses the root of the grammar on the reader, reading the buffer size at once, and more while a tree reaches the end of the buffer
+func (app *application) Stream(grammar grammars.Grammar, reader io.Reader, bufferSize uint) (Stream, error) {
+	if bufferSize <= 0 {
+		return nil, errors.New("the buffer size of the stream must be greater than zero")
+	}
+
+	return createStream(app, grammar, reader, bufferSize), nil
+}
+
+// Ambiguities executes grammar on data while exploring every line of the tokens, and returns the spans matched by more than one line
+func (app *application) Ambiguities(grammar grammars.Grammar, values []byte) ([]Ambiguity, error) {
+	execution := createExecution(values, app.pMemoLimit)
+	execution.isExhaustive = true
+	tree, err := app.grammar(grammar, false, execution, []byte{}, values)
+	if err != nil {
+		return nil, app.parseError(execution, err)
+	}
+
+	return app.ambiguities(tree, map[string]bool{}), nil
+}
+
+// ambiguities returns the ambiguities of the tree, searching the derivations of the selected line and of its alternatives
+func (app *application) ambiguities(tree trees.Tree, found map[string]bool) []Ambiguity {
+	list := []Ambiguity{}
+	token := tree.Token()
+	if !token.HasSuccessful() {
+		return list
+	}
+
+	successful := token.Successful()
+	matches := []trees.Line{
+		successful,
+	}
+
+	if token.HasAlternatives() {
+		matches = append(matches, token.Alternatives()...)
+		sort.SliceStable(matches, func(i int, j int) bool {
+			return matches[i].Index() < matches[j].Index()
+		})
+
+		// group the lines by span, in the order of their first line:
+		keynames := []string{}
+		groups := map[string][]trees.Line{}
+		positions := map[string]trees.Position{}
+		for _, oneLine := range matches {
+			if !oneLine.HasPosition() {
+				continue
+			}
+
+			position := oneLine.Position()
+			keyname := fmt.Sprintf("%d:%d", position.Start().Offset(), position.End().Offset())
+			if _, ok := groups[keyname]; !ok {
+				keynames = append(keynames, keyname)
+				positions[keyname] = position
+			}
+
+			groups[keyname] = append(groups[keyname], oneLine)
+		}
+
+		for _, oneKeyname := range keynames {
+			// the derivations of the alternatives can share the same subtrees:
+			ambiguityKeyname := fmt.Sprintf("%s:%s", tree.Grammar().Hash().String(), oneKeyname)
+			if len(groups[oneKeyname]) <= 1 || found[ambiguityKeyname] {
+				continue
+			}
+
+			found[ambiguityKeyname] = true
+			list = append(list, createAmbiguity(tree.Grammar(), positions[oneKeyname], groups[oneKeyname]))
+		}
+	}
+
+	for _, oneLine := range matches {
+		for _, oneElement := range oneLine.Elements() {
+			for _, oneContent := range oneElement.Contents() {
+				if !oneContent.IsTree() {
+					continue
+				}
+
+				list = append(list, app.ambiguities(oneContent.Tree(), found)...)
+			}
+		}
+	}
+
+	return list
 }
 
 // Coverages returns the coverages of a grammar
 func (app *application) Coverages(reference references.Reference) (coverages.Coverages, error) {
 	grammar := reference.Root()
-	return app.coverages(reference, grammar)
+	ins, err := app.coverages(reference, grammar, &map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+
+	if ins == nil {
+		return nil, nil
+	}
+
+	tokens, err := app.coveragesTokens(reference, ins)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.coveragesBuilder.Create().WithList(ins.List()).WithTokens(tokens).Now()
+}
+
+func (app *application) coveragesTokens(reference references.Reference, ins coverages.Coverages) ([]coverages.Token, error) {
+	elements := map[string]map[uint]map[uint]string{}
+	err := app.findElements(reference, reference.Root(), &elements)
+	if err != nil {
+		return nil, err
+	}
+
+	covered := map[string]map[uint]map[uint]string{}
+	app.findCoveraredElements(ins, &covered)
+
+	output := []coverages.Token{}
+	skip := map[string]bool{}
+	referenceTokens := reference.Tokens().List()
+	for _, oneReferenceToken := range referenceTokens {
+		token := oneReferenceToken.Reference()
+		tokenHashStr := token.Hash().String()
+		if _, ok := elements[tokenHashStr]; !ok || skip[tokenHashStr] {
+			continue
+		}
+
+		skip[tokenHashStr] = true
+
+		linesList := []coverages.Line{}
+		lines := token.Lines()
+		for lineIdx, oneLine := range lines {
+			castedIdx := uint(lineIdx)
+			coveredElements, isLineCovered := covered[tokenHashStr][castedIdx]
+			elementsList := []coverages.Element{}
+			grElements := oneLine.Elements()
+			for elementIdx, oneElement := range grElements {
+				castedElIdx := uint(elementIdx)
+				builder := app.coverageElementBuilder.Create().WithIndex(castedElIdx).WithReference(oneElement)
+				if _, ok := coveredElements[castedElIdx]; ok {
+					builder.IsCovered()
+				}
+
+				element, err := builder.Now()
+				if err != nil {
+					return nil, err
+				}
+
+				elementsList = append(elementsList, element)
+			}
+
+			builder := app.coverageLineBuilder.Create().WithIndex(castedIdx).WithElements(elementsList)
+			if isLineCovered {
+				builder.IsCovered()
+			}
+
+			line, err := builder.Now()
+			if err != nil {
+				return nil, err
+			}
+
+			linesList = append(linesList, line)
+		}
+
+		coverageToken, err := app.coverageTokenBuilder.Create().WithReference(oneReferenceToken).WithLines(linesList).Now()
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, coverageToken)
+	}
+
+	return output, nil
 }
 
-func (app *application) coverages(reference references.Reference, grammar grammars.Grammar) (coverages.Coverages, error) {
+func (app *application) coverages(reference references.Reference, grammar grammars.Grammar, pSkip *map[string]bool) (coverages.Coverages, error) {
 	root := grammar.Root()
 	channels := grammar.Channels()
-	skip := map[string]bool{}
-	rootCoverages, err := app.coveragesToken(reference, root, channels, &skip)
+	rootCoverages, err := app.coveragesToken(reference, root, channels, pSkip)
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +315,7 @@ func (app *application) coverages(reference references.Reference, grammar gramma
 		channels := grammar.Channels()
 		for _, oneChannel := range channels {
 			token := oneChannel.Token()
-			coverages, err := app.coveragesToken(reference, token, nil, &skip)
+			coverages, err := app.coveragesToken(reference, token, nil, pSkip)
 			if err != nil {
 				return nil, err
 			}
@@ -142,7 +371,7 @@ func (app *application) coveragesToken(reference references.Reference, token gra
 			content := oneElement.Content()
 			if content.IsGrammar() {
 				grammar := content.Grammar()
-				coverages, err := app.coverages(reference, grammar)
+				coverages, err := app.coverages(reference, grammar, pSkip)
 				if err != nil {
 					return nil, err
 				}
@@ -222,7 +451,13 @@ func (app *application) coveragesToken(reference references.Reference, token gra
 
 func (app *application) coverageTokenSuite(reference references.Reference, token grammars.Token, channels []grammars.Channel, suite grammars.Suite) (coverages.Execution, error) {
 	input := suite.Content()
-	tree, _, err := app.token(token, map[string]*stack{}, nil, channels, false, []byte{}, input)
+	execution := createExecution(input, app.pMemoLimit)
+	tree, _, err := app.token(token, map[string]*stack{}, nil, channels, false, execution, []byte{}, input)
+	if err == nil && app.isStrict && tree.HasRemaining() {
+		err = app.remainingError(execution, tree.Remaining())
+		tree = nil
+	}
+
 	resultBuilder := app.coverageResultBuilder.Create()
 	if tree != nil {
 		resultBuilder.WithTree(tree)
@@ -327,88 +562,91 @@ func (app *application) findElementsFromToken(reference references.Reference, to
 	return nil
 }
 
-func (app *application) findCoveraredElements(reference references.Reference, coverages coverages.Coverages, pCovered *map[string]map[uint]map[uint]string) error {
+func (app *application) findCoveraredElements(coverages coverages.Coverages, pCovered *map[string]map[uint]map[uint]string) {
 	list := coverages.List()
 	for _, oneCoverage := range list {
-		tokenName := oneCoverage.Token().Name()
 		executionsList := oneCoverage.Executions().List()
 		for _, oneExecution := range executionsList {
-			result := oneExecution.Result()
-			if !result.IsTree() {
+			if !oneExecution.Expectation().IsValid() || !oneExecution.Passed() {
 				continue
 			}
 
-			token := result.Tree().Token()
-			err := app.findCoveraredElementsFromToken(reference, tokenName, token, pCovered)
-			if err != nil {
-				return err
-			}
+			app.findCoveraredElementsFromTree(oneExecution.Result().Tree(), pCovered)
 		}
 	}
+}
 
-	return nil
+func (app *application) findCoveraredElementsFromTrees(ins trees.Trees, pCovered *map[string]map[uint]map[uint]string) {
+	list := ins.List()
+	for _, oneTree := range list {
+		app.findCoveraredElementsFromTree(oneTree, pCovered)
+	}
 }
 
-func (app *application) findCoveraredElementsFromToken(reference references.Reference, tokenName string, token trees.Token, pCovered *map[string]map[uint]map[uint]string) error {
+func (app *application) findCoveraredElementsFromTree(tree trees.Tree, pCovered *map[string]map[uint]map[uint]string) {
+	if tree.HasPrefix() {
+		app.findCoveraredElementsFromTrees(tree.Prefix(), pCovered)
+	}
+
+	if tree.HasSuffix() {
+		app.findCoveraredElementsFromTrees(tree.Suffix(), pCovered)
+	}
+
+	token := tree.Token()
 	if !token.HasSuccessful() {
-		return nil
+		return
+	}
+
+	covered := *pCovered
+	tokenHashStr := tree.Grammar().Hash().String()
+	if _, ok := covered[tokenHashStr]; !ok {
+		covered[tokenHashStr] = map[uint]map[uint]string{}
 	}
 
 	line := token.Successful()
 	index := line.Index()
+	if _, ok := covered[tokenHashStr][index]; !ok {
+		covered[tokenHashStr][index] = map[uint]string{}
+	}
+
+	// the tree only contains the elements that matched, in the order of the grammar's line:
+	cursor := 0
+	grElements := line.Grammar().Elements()
 	elementsList := line.Elements()
-	for elIdx, oneElement := range elementsList {
+	for _, oneElement := range elementsList {
 		if !oneElement.HasGrammar() {
 			continue
 		}
 
-		grammar := oneElement.Grammar()
-		if !reference.HasGrammars() {
-			str := fmt.Sprintf("the grammar (hash: %s) is not name referenced", grammar.Hash().String())
-			return errors.New(str)
+		elementHash := oneElement.Grammar().Hash()
+		for cursor < len(grElements) && !grElements[cursor].Hash().Compare(elementHash) {
+			cursor++
 		}
 
-		referenceGrammar, err := reference.Grammars().Fetch(grammar.Hash())
-		if err != nil {
-			return err
-		}
-
-		elementName := referenceGrammar.Name()
-		covered := *pCovered
-		if _, ok := covered[tokenName]; !ok {
-			covered[tokenName] = map[uint]map[uint]string{}
+		if cursor >= len(grElements) {
+			break
 		}
 
-		if _, ok := covered[tokenName][index]; !ok {
-			covered[tokenName][index] = map[uint]string{}
-		}
-
-		castedElIdx := uint(elIdx)
-		if _, ok := covered[tokenName][index][castedElIdx]; !ok {
-			covered[tokenName][index][castedElIdx] = elementName
-		}
+		covered[tokenHashStr][index][uint(cursor)] = elementHash.String()
+		cursor++
 
 		contents := oneElement.Contents()
 		for _, oneContent := range contents {
+			if oneContent.IsValue() && oneContent.Value().HasPrefix() {
+				app.findCoveraredElementsFromTrees(oneContent.Value().Prefix(), &covered)
+			}
+
 			if oneContent.IsTree() {
-				subToken := oneContent.Tree().Token()
-				err := app.findCoveraredElementsFromToken(reference, elementName, subToken, &covered)
-				if err != nil {
-					return err
-				}
+				app.findCoveraredElementsFromTree(oneContent.Tree(), &covered)
 			}
 		}
-
-		pCovered = &covered
 	}
-
-	return nil
 }
 
-func (app *application) grammar(grammar grammars.Grammar, isReverse bool, prevData []byte, currentData []byte) (trees.Tree, error) {
+func (app *application) grammar(grammar grammars.Grammar, isReverse bool, execution *execution, prevData []byte, currentData []byte) (trees.Tree, error) {
 	root := grammar.Root()
 	channels := grammar.Channels()
-	tree, _, err := app.token(root, map[string]*stack{}, nil, channels, isReverse, prevData, currentData)
+	tree, _, err := app.token(root, map[string]*stack{}, nil, channels, isReverse, execution, prevData, currentData)
 	if err != nil {
 		return nil, err
 	}
@@ -416,18 +654,115 @@ func (app *application) grammar(grammar grammars.Grammar, isReverse bool, prevDa
 	return tree, nil
 }
 
-func (app *application) token(token grammars.Token, stackMap map[string]*stack, escape grammars.Token, channels []grammars.Channel, isReverse bool, prevData []byte, currentData []byte) (trees.Tree, map[string]*stack, error) {
+func (app *application) token(token grammars.Token, stackMap map[string]*stack, escape grammars.Token, channels []grammars.Channel, isReverse bool, execution *execution, prevData []byte, currentData []byte) (trees.Tree, map[string]*stack, error) {
+	recursion := app.recursionContext(execution, token, stackMap)
+	key := execution.memoKey(token, escape, channels, recursion, isReverse, prevData, currentData)
+	if entry, ok := execution.fetch(key); ok {
+		return entry.tree, stackMap, entry.err
+	}
+
+	err := execution.budget.step()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// if the token is already being parsed on the same data, it is left recursive, therefore return its seed:
+	if seed, ok := execution.seed(key); ok {
+		seed.hits++
+		execution.memo.guards++
+		if seed.tree == nil {
+			str := fmt.Sprintf("the left recursive token (hash: %s) has not grown a seed yet", token.Hash().String())
+			return nil, nil, errors.New(str)
+		}
+
+		return seed.tree, stackMap, nil
+	}
+
+	guards := execution.memo.guards
+	seed := execution.plant(key)
+	execution.budget.depth++
+	tree, retStackMap, err := app.tokenTree(token, stackMap, escape, channels, isReverse, execution, prevData, currentData)
+
+	// grow the seed for as long as the left recursion consumes more data:
+	for seed.hits > 0 && err == nil {
+		seed.tree = tree
+		grownTree, grownStackMap, grownErr := app.tokenTree(token, stackMap, escape, channels, isReverse, execution, prevData, currentData)
+		if grownErr != nil || app.remainder(grownTree) >= app.remainder(tree) {
+			break
+		}
+
+		tree = grownTree
+		retStackMap = grownStackMap
+	}
+
+	// the results that went through a loop guard, or the seed of another token, depend on the stack and therefore are not memoized:
+	execution.budget.depth--
+	execution.harvest(key)
+	if execution.budget.err != nil {
+		return nil, nil, execution.budget.err
+	}
+
+	if guards+seed.hits == execution.memo.guards {
+		execution.remember(key, tree, err)
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tree, retStackMap, nil
+}
+
+// recursionContext returns the hashes of the enclosing tokens that the recursive names reached from the token resolve to
+func (app *application) recursionContext(execution *execution, token grammars.Token, stackMap map[string]*stack) string {
+	recursion := []byte{}
+	for _, oneName := range execution.recursives(token) {
+		if stack, ok := app.fetchStack(stackMap, oneName); ok {
+			recursion = append(recursion, stack.token.Hash()...)
+		}
+
+		recursion = append(recursion, 0)
+	}
+
+	return string(recursion)
+}
+
+func (app *application) remainder(tree trees.Tree) int {
+	if !tree.HasRemaining() {
+		return 0
+	}
+
+	return len(tree.Remaining())
+}
+
+func (app *application) tokenTree(token grammars.Token, stackMap map[string]*stack, escape grammars.Token, channels []grammars.Channel, isReverse bool, execution *execution, prevData []byte, currentData []byte) (trees.Tree, map[string]*stack, error) {
 	tokenHashStr := token.Hash().String()
-	if _, ok := stackMap[tokenHashStr]; !ok {
+	entered, isEntered := stackMap[tokenHashStr]
+	if !isEntered {
 		stackMap[tokenHashStr] = &stack{
 			token: token,
 			lines: map[int][]byte{},
 		}
 	}
 
+	// the lines guarded by this call are released once it returns, so that growing a nested left recursive seed can go through them again:
+	guarded := map[int][]byte{}
+	if isEntered {
+		for idx, data := range entered.lines {
+			guarded[idx] = data
+		}
+	}
+
 	tokenLines := token.Lines()
-	treeToken, remaining, retStackMap, err := app.lines(token, stackMap, tokenLines, escape, channels, isReverse, prevData, currentData)
-	delete(stackMap, tokenHashStr)
+	execution.enter(token)
+	treeToken, remaining, retStackMap, err := app.lines(token, stackMap, tokenLines, escape, channels, isReverse, execution, prevData, currentData)
+	execution.leave()
+	if !isEntered {
+		delete(stackMap, tokenHashStr)
+	} else {
+		entered.lines = guarded
+	}
+
 	if err != nil {
 		return nil, nil, err
 	}
@@ -440,7 +775,7 @@ func (app *application) token(token grammars.Token, stackMap map[string]*stack,
 
 	builder := app.treeBuilder.Create().WithGrammar(token).WithToken(treeToken)
 	if channels != nil {
-		suffix, rem, err := app.channels(channels, prevData, remaining)
+		suffix, rem, err := app.channels(channels, execution, prevData, remaining)
 		if err == nil {
 			builder.WithSuffix(suffix)
 			remaining = rem
@@ -456,36 +791,77 @@ func (app *application) token(token grammars.Token, stackMap map[string]*stack,
 		return nil, nil, err
 	}
 
+	err = execution.budget.node()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	return ins, stackMap, nil
 }
 
-func (app *application) external(external grammars.Grammar, isReverse bool, prevData []byte, currentData []byte) (trees.Tree, error) {
-	treeIns, err := app.grammar(external, isReverse, prevData, currentData)
+func (app *application) external(external grammars.Grammar, channels []grammars.Channel, isReverse bool, execution *execution, prevData []byte, currentData []byte) (trees.Tree, error) {
+	// the channels of the enclosing grammar are consumed before entering the external grammar:
+	remaining := currentData
+	var prefix trees.Trees
+	if channels != nil {
+		channelTrees, rem, err := app.channels(channels, execution, prevData, remaining)
+		if err == nil {
+			prefix = channelTrees
+			remaining = rem
+		}
+	}
+
+	treeIns, err := app.grammar(external, isReverse, execution, prevData, remaining)
 	if err != nil {
 		return nil, err
 	}
 
-	lines := external.Root().Lines()
-	grammarRoot, err := app.grammarTokenBuilder.Create().WithLines(lines).Now()
+	root := external.Root()
+	grammarRootBuilder := app.grammarTokenBuilder.Create().WithLines(root.Lines())
+	if root.HasName() {
+		grammarRootBuilder.WithName(root.Name())
+	}
+
+	grammarRoot, err := grammarRootBuilder.Now()
 	if err != nil {
 		return nil, err
 	}
 
 	treeToken := treeIns.Token()
-	return app.treeBuilder.Create().WithGrammar(grammarRoot).WithToken(treeToken).Now()
+	builder := app.treeBuilder.Create().WithGrammar(grammarRoot).WithToken(treeToken)
+	if prefix != nil {
+		builder.WithPrefix(prefix)
+	}
+
+	if treeIns.HasSuffix() {
+		builder.WithSuffix(treeIns.Suffix())
+	}
+
+	if treeIns.HasRemaining() {
+		builder.WithRemaining(treeIns.Remaining())
+	}
+
+	return builder.Now()
 }
 
-func (app *application) lines(token grammars.Token, stackMap map[string]*stack, lines []grammars.Line, escape grammars.Token, channels []grammars.Channel, isReverse bool, prevData []byte, currentData []byte) (trees.Token, []byte, map[string]*stack, error) {
+func (app *application) lines(token grammars.Token, stackMap map[string]*stack, lines []grammars.Line, escape grammars.Token, channels []grammars.Channel, isReverse bool, execution *execution, prevData []byte, currentData []byte) (trees.Token, []byte, map[string]*stack, error) {
 	tokenHashstr := token.Hash().String()
 	list := []trees.Line{}
 	remaining := currentData
 	currentStack := stackMap
 
+	// in longest match or exhaustive mode, every successful line is kept, then the selected one is the first or the one that consumes the most data:
+	matches := []trees.Line{}
+	matchIndex := -1
+	var matchRemaining []byte
+	var matchStack map[string]*stack
+
 	for idx, oneLine := range lines {
 		// if we already went through this line, with the same data, in the stack, skip it to avoid infinite loops:
 		if _, ok := currentStack[tokenHashstr]; ok {
 			if data, ok := currentStack[tokenHashstr].lines[idx]; ok {
 				if bytes.Compare(remaining, data) == 0 {
+					execution.memo.guards++
 					continue
 				}
 
@@ -500,6 +876,7 @@ func (app *application) lines(token grammars.Token, stackMap map[string]*stack,
 		}
 
 		currentStack[tokenHashstr].lines[idx] = remaining
+		execution.line(uint(idx))
 
 		// if the line is in reverse:
 		if isReverse {
@@ -512,17 +889,22 @@ func (app *application) lines(token grammars.Token, stackMap map[string]*stack,
 				}
 
 				if escape != nil {
-					escapeTree, _, err := app.token(escape, stackMap, nil, channels, false, previousData, remaining)
+					escapeTree, _, err := app.token(escape, stackMap, nil, channels, false, execution, previousData, remaining)
 					if err == nil {
 						if escapeTree.Token().HasSuccessful() {
 							if escapeTree.HasRemaining() {
 								escapeRemaining := escapeTree.Remaining()
-								treeLine, rem, _, err := app.line(currentStack, oneLine, uint(idx), escape, channels, isReverse, remaining, escapeRemaining)
+								treeLine, rem, _, err := app.line(currentStack, oneLine, uint(idx), escape, channels, isReverse, execution, remaining, escapeRemaining)
 								if err == nil && treeLine.IsSuccessful() {
 									amount := len(escapeRemaining) - len(rem)
 									values := escapeRemaining[:amount]
-									for _, oneValue := range values {
-										value, err := app.treeValueBuilder.Create().WithContent([]byte{oneValue}).Now()
+									for valueIdx, oneValue := range values {
+										position, err := app.position(execution, escapeRemaining[valueIdx:], 1)
+										if err != nil {
+											return nil, nil, nil, err
+										}
+
+										value, err := app.treeValueBuilder.Create().WithContent([]byte{oneValue}).WithPosition(position).Now()
 										if err != nil {
 											return nil, nil, nil, err
 										}
@@ -543,14 +925,19 @@ func (app *application) lines(token grammars.Token, stackMap map[string]*stack,
 					}
 				}
 
-				_, _, _, err := app.line(currentStack, oneLine, uint(idx), escape, channels, isReverse, previousData, remaining)
+				_, _, _, err := app.line(currentStack, oneLine, uint(idx), escape, channels, isReverse, execution, previousData, remaining)
 				if err == nil {
 					break
 				}
 
+				position, err := app.position(execution, remaining, 1)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+
 				value, err := app.treeValueBuilder.Create().WithContent([]byte{
 					remaining[0],
-				}).Now()
+				}).WithPosition(position).Now()
 
 				if err != nil {
 					return nil, nil, nil, err
@@ -589,11 +976,23 @@ func (app *application) lines(token grammars.Token, stackMap map[string]*stack,
 		}
 
 		// the line is NOT in reverse:
-		lineIns, rem, retStack, err := app.line(currentStack, oneLine, uint(idx), escape, channels, isReverse, prevData, remaining)
+		lineIns, rem, retStack, err := app.line(currentStack, oneLine, uint(idx), escape, channels, isReverse, execution, prevData, remaining)
 		if err != nil {
 			continue
 		}
 
+		if (app.isLongestMatch || execution.isExhaustive) && lineIns.IsSuccessful() {
+			// on ties, the line with the lowest index is kept:
+			if matchIndex < 0 || (app.isLongestMatch && len(rem) < len(matchRemaining)) {
+				matchIndex = len(matches)
+				matchRemaining = rem
+				matchStack = retStack
+			}
+
+			matches = append(matches, lineIns)
+			continue
+		}
+
 		// add the line to the list:
 		list = append(list, lineIns)
 		if lineIns.IsSuccessful() {
@@ -603,12 +1002,21 @@ func (app *application) lines(token grammars.Token, stackMap map[string]*stack,
 		}
 	}
 
+	alternatives := []trees.Line{}
+	if matchIndex >= 0 {
+		list = append(list, matches[matchIndex])
+		alternatives = append(alternatives, matches[:matchIndex]...)
+		alternatives = append(alternatives, matches[matchIndex+1:]...)
+		remaining = matchRemaining
+		currentStack = matchStack
+	}
+
 	// if there is no line:
 	if len(list) <= 0 {
 		return nil, remaining, currentStack, nil
 	}
 
-	blockIns, err := app.treeTokenBuilder.Create().WithLines(list).Now()
+	blockIns, err := app.treeTokenBuilder.Create().WithLines(list).WithAlternatives(alternatives).Now()
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -616,13 +1024,14 @@ func (app *application) lines(token grammars.Token, stackMap map[string]*stack,
 	return blockIns, remaining, currentStack, nil
 }
 
-func (app *application) line(stackMap map[string]*stack, line grammars.Line, index uint, escape grammars.Token, channels []grammars.Channel, isReverse bool, prevData []byte, currentData []byte) (trees.Line, []byte, map[string]*stack, error) {
+func (app *application) line(stackMap map[string]*stack, line grammars.Line, index uint, escape grammars.Token, channels []grammars.Channel, isReverse bool, execution *execution, prevData []byte, currentData []byte) (trees.Line, []byte, map[string]*stack, error) {
 	list := []trees.Element{}
 	grElements := line.Elements()
 	remaining := currentData
 	previousData := prevData
 	currentStack := stackMap
-	for _, oneElement := range grElements {
+	for elementIdx, oneElement := range grElements {
+		execution.element(uint(elementIdx))
 		contentsList := []trees.Content{}
 		cardinality := oneElement.Cardinality()
 		pMax := cardinality.Max()
@@ -639,7 +1048,7 @@ func (app *application) line(stackMap map[string]*stack, line grammars.Line, ind
 				}
 			}
 
-			contentIns, rem, retStack, err := app.element(oneElement, currentStack, escape, channels, isReverse, previousData, remaining)
+			contentIns, rem, retStack, err := app.element(oneElement, currentStack, escape, channels, isReverse, execution, previousData, remaining)
 			if err != nil {
 				break
 			}
@@ -652,6 +1061,7 @@ func (app *application) line(stackMap map[string]*stack, line grammars.Line, ind
 
 		min := int(cardinality.Min())
 		if len(contentsList) < min {
+			execution.fail(remaining)
 			str := fmt.Sprintf("the expected minimum content amount (%d) was not reached (%d) and therefore the element is invalid", min, len(contentsList))
 			return nil, nil, nil, errors.New(str)
 		}
@@ -682,13 +1092,13 @@ func (app *application) line(stackMap map[string]*stack, line grammars.Line, ind
 	return lineIns, remaining, currentStack, nil
 }
 
-func (app *application) element(element grammars.Element, stackMap map[string]*stack, escape grammars.Token, channels []grammars.Channel, isReverse bool, prevData []byte, currentData []byte) (trees.Content, []byte, map[string]*stack, error) {
+func (app *application) element(element grammars.Element, stackMap map[string]*stack, escape grammars.Token, channels []grammars.Channel, isReverse bool, execution *execution, prevData []byte, currentData []byte) (trees.Content, []byte, map[string]*stack, error) {
 	if len(currentData) <= 0 {
 		return nil, nil, nil, errors.New("no remaining data")
 	}
 
 	content := element.Content()
-	value, tree, rem, retStack, err := app.elementContent(content, stackMap, escape, channels, isReverse, prevData, currentData)
+	value, tree, rem, retStack, err := app.elementContent(content, stackMap, escape, channels, isReverse, execution, prevData, currentData)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -718,10 +1128,10 @@ func (app *application) element(element grammars.Element, stackMap map[string]*s
 	return contentIns, rem, retStack, nil
 }
 
-func (app *application) elementContent(content grammars.ElementContent, stackMap map[string]*stack, escape grammars.Token, channels []grammars.Channel, isReverse bool, prevData []byte, currentData []byte) (trees.Value, trees.Tree, []byte, map[string]*stack, error) {
+func (app *application) elementContent(content grammars.ElementContent, stackMap map[string]*stack, escape grammars.Token, channels []grammars.Channel, isReverse bool, execution *execution, prevData []byte, currentData []byte) (trees.Value, trees.Tree, []byte, map[string]*stack, error) {
 	if content.IsGrammar() {
 		external := content.Grammar()
-		tree, err := app.external(external, isReverse, prevData, currentData)
+		tree, err := app.external(external, channels, isReverse, execution, prevData, currentData)
 		if err != nil {
 			return nil, nil, nil, nil, err
 		}
@@ -740,7 +1150,7 @@ func (app *application) elementContent(content grammars.ElementContent, stackMap
 
 	if content.IsInstance() {
 		instance := content.Instance()
-		tree, retStack, err := app.instance(instance, stackMap, escape, channels, isReverse, prevData, currentData)
+		tree, retStack, err := app.instance(instance, stackMap, escape, channels, isReverse, execution, prevData, currentData)
 		if err != nil {
 			return nil, nil, nil, nil, err
 		}
@@ -755,8 +1165,8 @@ func (app *application) elementContent(content grammars.ElementContent, stackMap
 
 	if content.IsRecursive() {
 		recursive := content.Recursive()
-		if stack, ok := stackMap[recursive]; ok {
-			tree, retStack, err := app.token(stack.token, stackMap, escape, channels, isReverse, prevData, currentData)
+		if stack, ok := app.fetchStack(stackMap, recursive); ok {
+			tree, retStack, err := app.token(stack.token, stackMap, escape, channels, isReverse, execution, prevData, currentData)
 			if err != nil {
 				return nil, nil, nil, nil, err
 			}
@@ -778,7 +1188,7 @@ func (app *application) elementContent(content grammars.ElementContent, stackMap
 	}
 
 	grValue := content.Value()
-	value, remaining, retStack, err := app.elementValue(grValue, stackMap, escape, channels, isReverse, prevData, currentData)
+	value, remaining, retStack, err := app.elementValue(grValue, stackMap, escape, channels, isReverse, execution, prevData, currentData)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
@@ -786,11 +1196,25 @@ func (app *application) elementContent(content grammars.ElementContent, stackMap
 	return value, nil, remaining, retStack, nil
 }
 
-func (app *application) elementValue(value []byte, stackMap map[string]*stack, escape grammars.Token, channels []grammars.Channel, isReverse bool, prevData []byte, currentData []byte) (trees.Value, []byte, map[string]*stack, error) {
+func (app *application) fetchStack(stackMap map[string]*stack, recursive string) (*stack, bool) {
+	if stack, ok := stackMap[recursive]; ok {
+		return stack, true
+	}
+
+	for _, oneStack := range stackMap {
+		if oneStack.token.HasName() && oneStack.token.Name() == recursive {
+			return oneStack, true
+		}
+	}
+
+	return nil, false
+}
+
+func (app *application) elementValue(value []byte, stackMap map[string]*stack, escape grammars.Token, channels []grammars.Channel, isReverse bool, execution *execution, prevData []byte, currentData []byte) (trees.Value, []byte, map[string]*stack, error) {
 	remaining := currentData
 	builder := app.treeValueBuilder.Create()
 	if channels != nil {
-		prefix, rem, err := app.channels(channels, prevData, remaining)
+		prefix, rem, err := app.channels(channels, execution, prevData, remaining)
 		if err == nil {
 			builder.WithPrefix(prefix)
 			remaining = rem
@@ -798,38 +1222,119 @@ func (app *application) elementValue(value []byte, stackMap map[string]*stack, e
 	}
 
 	if len(remaining) < 1 {
+		execution.fail(remaining)
 		return nil, nil, nil, errors.New("there must be at least 1 value in the given data in order to have an element match, 0 provided")
 	}
 
 	if bytes.HasPrefix(remaining, value) {
-		ins, err := builder.WithContent(value).Now()
+		position, err := app.position(execution, remaining, len(value))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		ins, err := builder.WithContent(value).WithPosition(position).Now()
 		if err != nil {
 			return nil, nil, nil, err
 		}
 
-		return ins, remaining[1:], stackMap, nil
+		return ins, remaining[len(value):], stackMap, nil
 	}
 
+	execution.fail(remaining)
 	return nil, nil, nil, nil
 }
 
-func (app *application) instance(instance grammars.Instance, stackMap map[string]*stack, escape grammars.Token, channels []grammars.Channel, isReverse bool, prevData []byte, currentData []byte) (trees.Tree, map[string]*stack, error) {
+func (app *application) parseError(execution *execution, err error) error {
+	position, posErr := app.treePositionBuilder.Create().
+		WithInput(execution.input).
+		WithStart(execution.furthest).
+		WithEnd(execution.furthest).
+		Now()
+
+	if posErr != nil {
+		return err
+	}
+
+	expectations := []Expectation{}
+	found := map[string]bool{}
+	for _, oneFailure := range execution.failures {
+		keyname := ""
+		for _, oneFrame := range oneFailure {
+			keyname = fmt.Sprintf("%s/%s:%d:%d", keyname, oneFrame.token.Hash().String(), oneFrame.line, oneFrame.element)
+		}
+
+		if _, ok := found[keyname]; ok {
+			continue
+		}
+
+		found[keyname] = true
+		last := oneFailure[len(oneFailure)-1]
+		parents := []grammars.Token{}
+		for _, oneFrame := range oneFailure[:len(oneFailure)-1] {
+			parents = append(parents, oneFrame.token)
+		}
+
+		element := last.token.Lines()[last.line].Elements()[last.element]
+		expectations = append(expectations, createExpectation(last.token, last.line, element, parents))
+	}
+
+	return createParseError(execution.input, position.Start(), expectations)
+}
+
+func (app *application) remainingError(execution *execution, remaining []byte) error {
+	// the furthest failure explains why the parsing stopped, unless no failure was recorded past the remaining data:
+	offset := execution.offset(remaining)
+	str := fmt.Sprintf("the input was expected to be entirely consumed, %d remaining bytes at offset %d", len(remaining), offset)
+	err := errors.New(str)
+	if len(execution.failures) > 0 && execution.furthest >= offset {
+		return app.parseError(execution, err)
+	}
+
+	position, posErr := app.treePositionBuilder.Create().
+		WithInput(execution.input).
+		WithStart(offset).
+		WithEnd(offset).
+		Now()
+
+	if posErr != nil {
+		return err
+	}
+
+	return createParseError(execution.input, position.Start(), []Expectation{})
+}
+
+func (app *application) position(execution *execution, data []byte, length int) (trees.Position, error) {
+	start := execution.offset(data)
+	builder := app.treePositionBuilder.Create().
+		WithInput(execution.input).
+		WithNewLines(execution.newLines()).
+		WithStart(start).
+		WithEnd(start + uint(length))
+
+	if execution.origin != nil {
+		builder.WithOrigin(execution.origin)
+	}
+
+	return builder.Now()
+}
+
+func (app *application) instance(instance grammars.Instance, stackMap map[string]*stack, escape grammars.Token, channels []grammars.Channel, isReverse bool, execution *execution, prevData []byte, currentData []byte) (trees.Tree, map[string]*stack, error) {
 	if instance.IsToken() {
 		token := instance.Token()
-		return app.token(token, stackMap, escape, channels, isReverse, prevData, currentData)
+		return app.token(token, stackMap, escape, channels, isReverse, execution, prevData, currentData)
 	}
 
 	everything := instance.Everything()
-	return app.everything(everything, stackMap, isReverse, prevData, currentData)
+	return app.everything(everything, stackMap, isReverse, execution, prevData, currentData)
 }
 
-func (app *application) everything(everything grammars.Everything, stackMap map[string]*stack, isReverse bool, prevData []byte, currentData []byte) (trees.Tree, map[string]*stack, error) {
+func (app *application) everything(everything grammars.Everything, stackMap map[string]*stack, isReverse bool, execution *execution, prevData []byte, currentData []byte) (trees.Tree, map[string]*stack, error) {
 	exception := everything.Exception()
 	escape := everything.Escape()
-	return app.token(exception, stackMap, escape, nil, !isReverse, prevData, currentData)
+	return app.token(exception, stackMap, escape, nil, !isReverse, execution.silent(), prevData, currentData)
 }
 
-func (app *application) channels(channels []grammars.Channel, prevData []byte, currentData []byte) (trees.Trees, []byte, error) {
+func (app *application) channels(channels []grammars.Channel, execution *execution, prevData []byte, currentData []byte) (trees.Trees, []byte, error) {
 	treeList := []trees.Tree{}
 	remaining := currentData
 	previousData := prevData
@@ -837,7 +1342,7 @@ func (app *application) channels(channels []grammars.Channel, prevData []byte, c
 	for {
 		beginAmount := len(treeList)
 		for _, oneChannel := range channels {
-			tree, err := app.channel(oneChannel, previousData, remaining)
+			tree, err := app.channel(oneChannel, execution, previousData, remaining)
 			if err != nil {
 				continue
 			}
@@ -870,9 +1375,9 @@ func (app *application) channels(channels []grammars.Channel, prevData []byte, c
 	return trees, remaining, nil
 }
 
-func (app *application) channel(channel grammars.Channel, prevData []byte, currentData []byte) (trees.Tree, error) {
+func (app *application) channel(channel grammars.Channel, execution *execution, prevData []byte, currentData []byte) (trees.Tree, error) {
 	token := channel.Token()
-	tree, _, err := app.token(token, map[string]*stack{}, nil, nil, false, prevData, currentData)
+	tree, _, err := app.token(token, map[string]*stack{}, nil, nil, false, execution.silent(), prevData, currentData)
 	if err != nil {
 		return nil, err
 	}
@@ -884,7 +1389,7 @@ func (app *application) channel(channel grammars.Channel, prevData []byte, curre
 		}
 
 		condition := channel.Condition()
-		isAccepted, err := app.channelCondition(condition, prevData, remaining)
+		isAccepted, err := app.channelCondition(condition, execution, prevData, remaining)
 		if err != nil {
 			return nil, err
 		}
@@ -897,11 +1402,11 @@ func (app *application) channel(channel grammars.Channel, prevData []byte, curre
 	return tree, nil
 }
 
-func (app *application) channelCondition(condition grammars.ChannelCondition, prevData []byte, nextData []byte) (bool, error) {
+func (app *application) channelCondition(condition grammars.ChannelCondition, execution *execution, prevData []byte, nextData []byte) (bool, error) {
 	isPrevMatch := true
 	if condition.HasPrevious() {
 		prevToken := condition.Previous()
-		tree, _, err := app.token(prevToken, map[string]*stack{}, nil, nil, false, []byte{}, prevData)
+		tree, _, err := app.token(prevToken, map[string]*stack{}, nil, nil, false, execution.detached(prevData), []byte{}, prevData)
 		if err != nil {
 			return false, err
 		}
@@ -912,7 +1417,7 @@ func (app *application) channelCondition(condition grammars.ChannelCondition, pr
 	isNextMatch := true
 	if condition.HasNext() {
 		nextToken := condition.Next()
-		tree, _, err := app.token(nextToken, map[string]*stack{}, nil, nil, false, []byte{}, nextData)
+		tree, _, err := app.token(nextToken, map[string]*stack{}, nil, nil, false, execution.detached(nextData), []byte{}, nextData)
 		if err != nil {
 			return false, err
 		}
@@ -0,0 +1,958 @@
+package applications_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/steve-care-software/grammars/applications"
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/trees"
+	"github.com/steve-care-software/grammars/infrastructure/scripts"
+)
+
+func TestApplication_withScript_positions_Success(t *testing.T) {
+	grammarApp := applications.NewApplication()
+	ins := scripts.NewGrammar().Grammar()
+	script := "@myRoot;\n\n-myChannel;\nmyRoot: first\n\t| second;\n"
+	treeIns, err := grammarApp.Execute(ins.Root(), []byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !treeIns.HasPosition() {
+		t.Errorf("the tree was expected to contain a position")
+		return
+	}
+
+	position := treeIns.Position()
+	if position.Start().Offset() != 0 || position.End().Offset() != uint(len(script)-1) {
+		t.Errorf("the tree position was expected to be [%d, %d], [%d, %d] returned", 0, len(script)-1, position.Start().Offset(), position.End().Offset())
+		return
+	}
+
+	expected := map[string][]uint{
+		"myRoot":    {1, 2, 1, 8},
+		"myChannel": {3, 2, 3, 11},
+		"second":    {5, 4, 5, 10},
+	}
+
+	found := map[string]bool{}
+	for _, oneTree := range fetchAll(treeIns, "variableName") {
+		name := string(oneTree.Bytes(false))
+		locations, ok := expected[name]
+		if !ok || found[name] {
+			continue
+		}
+
+		found[name] = true
+		start := oneTree.Position().Start()
+		end := oneTree.Position().End()
+		if start.Line() != locations[0] || start.Column() != locations[1] || end.Line() != locations[2] || end.Column() != locations[3] {
+			t.Errorf("the variable (%s) was expected to be at %d:%d-%d:%d, %d:%d-%d:%d returned", name, locations[0], locations[1], locations[2], locations[3], start.Line(), start.Column(), end.Line(), end.Column())
+			return
+		}
+	}
+
+	if len(found) != len(expected) {
+		t.Errorf("%d variables were expected to be found, %d found", len(expected), len(found))
+		return
+	}
+}
+
+// fetchAll returns the descendants of a tree that are named using the given name
+func fetchAll(tree trees.Tree, name string) []trees.Tree {
+	output := []trees.Tree{}
+	if !tree.Token().HasSuccessful() {
+		return output
+	}
+
+	for _, oneElement := range tree.Token().Successful().Elements() {
+		for _, oneContent := range oneElement.Contents() {
+			if !oneContent.IsTree() {
+				continue
+			}
+
+			child := oneContent.Tree()
+			if child.Grammar().Name() == name {
+				output = append(output, child)
+				continue
+			}
+
+			output = append(output, fetchAll(child, name)...)
+		}
+	}
+
+	return output
+}
+
+func TestApplication_withInvalidScript_returnsParseError(t *testing.T) {
+	grammarApp := applications.NewApplication()
+	ins := scripts.NewGrammar().Grammar()
+	script := "@myRoot;\nmyRoot: first\n\t| (second;\n"
+	_, err := grammarApp.Execute(ins.Root(), []byte(script))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	var parseErr applications.ParseError
+	if !errors.As(err, &parseErr) {
+		t.Errorf("the error was expected to be a ParseError")
+		return
+	}
+
+	location := parseErr.Location()
+	if location.Line() != 3 || location.Column() != 4 {
+		t.Errorf("the error was expected to be located at %d:%d, %d:%d returned", 3, 4, location.Line(), location.Column())
+		return
+	}
+
+	expected := parseErr.Expected(ins)
+	if len(expected) != 3 || expected[1] != "grammar > instruction > tokenAssignment > block > delimiterThenLine > line > element > variableName (line: 0): lowerCaseLetter" {
+		t.Errorf("the expectations were not the expected ones: %v", expected)
+		return
+	}
+
+	snippet := "\t| (second;\n\t  ^"
+	if parseErr.Snippet() != snippet {
+		t.Errorf("the snippet was expected to be: \n%s\n, returned: \n%s", snippet, parseErr.Snippet())
+		return
+	}
+}
+
+func TestApplication_withLimits_Success(t *testing.T) {
+	grammarApp := applications.NewApplication()
+	ins := scripts.NewGrammar().Grammar()
+	script := []byte("@myRoot;\n-myChannel;\nmyRoot: first\n\t| second;\n")
+	limitsBuilder := applications.NewLimitsBuilder()
+	kinds := map[uint8]applications.LimitsBuilder{
+		applications.LimitSteps: limitsBuilder.Create().WithSteps(10),
+		applications.LimitDepth: limitsBuilder.Create().WithDepth(3),
+		applications.LimitNodes: limitsBuilder.Create().WithNodes(5),
+	}
+
+	for oneKind, oneBuilder := range kinds {
+		limits, err := oneBuilder.Now()
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		_, err = grammarApp.ExecuteContext(context.Background(), ins.Root(), script, limits)
+		var limitErr applications.LimitError
+		if !errors.As(err, &limitErr) {
+			t.Errorf("the error was expected to be a LimitError, returned: %v", err)
+			return
+		}
+
+		if limitErr.Kind() != oneKind {
+			t.Errorf("the exceeded limit was expected to be %d, %d returned", oneKind, limitErr.Kind())
+			return
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := grammarApp.ExecuteContext(ctx, ins.Root(), script, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("the error was expected to be context.Canceled, returned: %v", err)
+		return
+	}
+
+	limits, err := limitsBuilder.Create().WithSteps(1000000).WithDepth(100).WithNodes(1000000).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = grammarApp.ExecuteContext(context.Background(), ins.Root(), script, limits)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+}
+
+func TestApplication_withMemoLimit_Success(t *testing.T) {
+	ins := scripts.NewGrammar().Grammar()
+	script := benchmarkScript(2)
+	expected, err := applications.NewApplication().Execute(ins.Root(), script)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	limits := []uint{0, 1, 64}
+	for _, oneLimit := range limits {
+		grammarApp, err := applications.NewBuilder().Create().WithMemoLimit(oneLimit).Now()
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		treeIns, err := grammarApp.Execute(ins.Root(), script)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if !bytes.Equal(expected.Bytes(true), treeIns.Bytes(true)) {
+			t.Errorf("the tree (memo limit: %d) was expected to be the same as the unbounded one", oneLimit)
+			return
+		}
+	}
+}
+
+func BenchmarkApplication_withScript(b *testing.B) {
+	grammarApp := applications.NewApplication()
+	ins := scripts.NewGrammar().Grammar()
+	amounts := []int{1, 2, 4, 8}
+	for _, oneAmount := range amounts {
+		script := benchmarkScript(oneAmount)
+		b.Run(fmt.Sprintf("size=%dx", oneAmount), func(b *testing.B) {
+			// the throughput is expected to remain stable as the script grows:
+			b.SetBytes(int64(len(script)))
+			for i := 0; i < b.N; i++ {
+				_, err := grammarApp.Execute(ins.Root(), script)
+				if err != nil {
+					b.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+					return
+				}
+			}
+		})
+	}
+}
+
+func benchmarkScript(amount int) []byte {
+	script := []byte("@expression;\n-space;\n")
+	for i := 0; i < amount; i++ {
+		script = append(script, []byte(`
+			expression: term plusTerm*
+				---
+				valid: onePlusTwo & two;
+				invalid: plus;
+			;
+
+			plusTerm: plus term;
+			term: number | number[0,1] | number[2,];
+			one: 49; two: 50; plus: 43;
+		`)...)
+	}
+
+	return script
+}
+
+func TestApplication_withMemo_stepsGrowLinearly(t *testing.T) {
+	script := `
+		@expression;
+		expression: term plus expression semicolon
+			| term plus expression
+			| term
+		;
+
+		term: open expression close | one;
+
+		one: 49;
+		plus: 43;
+		semicolon: 59;
+		open: 40;
+		close: 41;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// every line backtracks over the whole expression that follows it, which is exponential without the memo:
+	grammarApp := applications.NewApplication()
+	previous := uint(0)
+	for _, oneAmount := range []int{4, 8, 16, 32} {
+		input := []byte(strings.Repeat("(1+1)+", oneAmount) + "1")
+		steps, err := minimumSteps(grammarApp, reference.Root(), input)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if previous > 0 && steps > 2*previous {
+			t.Errorf("the steps were expected to grow linearly with the input, %d steps for %d bytes after %d steps for half of it", steps, len(input), previous)
+			return
+		}
+
+		previous = steps
+	}
+}
+
+// minimumSteps returns the smallest steps limit under which the input is completely parsed
+func minimumSteps(grammarApp applications.Application, grammar grammars.Grammar, input []byte) (uint, error) {
+	limitsBuilder := applications.NewLimitsBuilder()
+	low := uint(1)
+	high := uint(1 << 20)
+	for low < high {
+		middle := (low + high) / 2
+		limits, err := limitsBuilder.Create().WithSteps(middle).Now()
+		if err != nil {
+			return 0, err
+		}
+
+		tree, err := grammarApp.ExecuteContext(context.Background(), grammar, input, limits)
+		if err == nil && !tree.HasRemaining() {
+			high = middle
+			continue
+		}
+
+		low = middle + 1
+	}
+
+	return low, nil
+}
+
+func TestApplication_withLeftRecursion_Success(t *testing.T) {
+	script := `
+		@expression;
+		-space;
+
+		expression: expression plus number
+			| expression minus number
+			| number
+		;
+
+		number: digit+;
+		digit: zero | one | two;
+
+		zero: 48;
+		one: 49;
+		two: 50;
+		plus: 43;
+		minus: 45;
+		space: 32;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	grammarApp := applications.NewApplication()
+	tree, err := grammarApp.Execute(reference.Root(), []byte("10 + 2 - 1 + 21"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if tree.HasRemaining() {
+		t.Errorf("the tree was expected to NOT contain remaining data")
+		return
+	}
+
+	// the tree is expected to be left-associative:
+	expected := []string{
+		"10+2-1+21",
+		"10+2-1",
+		"10+2",
+		"10",
+	}
+
+	for idx, oneExpected := range expected {
+		if string(tree.Bytes(false)) != oneExpected {
+			t.Errorf("the tree (depth: %d) was expected to be %s, %s returned", idx, oneExpected, tree.Bytes(false))
+			return
+		}
+
+		tree = tree.Token().Successful().Elements()[0].Contents()[0].Tree()
+	}
+}
+
+func TestApplication_withNestedLeftRecursion_Success(t *testing.T) {
+	script := `
+		@expression;
+		expression: expression plus term
+			| expression minus term
+			| term
+		;
+
+		term: one | open expression close;
+
+		one: 49;
+		plus: 43;
+		minus: 45;
+		open: 40;
+		close: 41;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	longestApp, err := applications.NewBuilder().Create().IsLongestMatch().Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	strictApp, err := applications.NewBuilder().Create().IsStrict().Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	withoutMemoApp, err := applications.NewBuilder().Create().WithMemoLimit(0).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	applications := []applications.Application{
+		applications.NewApplication(),
+		longestApp,
+		strictApp,
+		withoutMemoApp,
+	}
+
+	for appIdx, oneApp := range applications {
+		for _, oneInput := range []string{"(1+1)+1", "1+(1+1)", "(1+(1-1))+1"} {
+			tree, err := oneApp.Execute(reference.Root(), []byte(oneInput))
+			if err != nil {
+				t.Errorf("the error was expected to be nil, error returned (application: %d, input: %s): %s", appIdx, oneInput, err.Error())
+				return
+			}
+
+			if tree.HasRemaining() {
+				t.Errorf("the tree (application: %d, input: %s) was expected to NOT contain remaining data, remaining: %s", appIdx, oneInput, tree.Remaining())
+				return
+			}
+		}
+
+		tree, err := oneApp.Execute(reference.Root(), []byte("1-(1+1)-1"))
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned (application: %d): %s", appIdx, err.Error())
+			return
+		}
+
+		// the tree is expected to be left-associative:
+		expected := []string{
+			"1-(1+1)-1",
+			"1-(1+1)",
+			"1",
+		}
+
+		for idx, oneExpected := range expected {
+			if string(tree.Bytes(false)) != oneExpected {
+				t.Errorf("the tree (application: %d, depth: %d) was expected to be %s, %s returned", appIdx, idx, oneExpected, tree.Bytes(false))
+				return
+			}
+
+			tree = tree.Token().Successful().Elements()[0].Contents()[0].Tree()
+		}
+	}
+}
+
+func TestApplication_withIndirectLeftRecursion_Success(t *testing.T) {
+	script := `
+		@member;
+		member: access | name;
+		access: member dot name;
+		name: letter+;
+		letter: lowerA | lowerB;
+
+		lowerA: 97;
+		lowerB: 98;
+		dot: 46;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	tree, err := applications.NewApplication().Execute(reference.Root(), []byte("ab.b.a"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if tree.HasRemaining() {
+		t.Errorf("the tree was expected to NOT contain remaining data, remaining: %s", tree.Remaining())
+		return
+	}
+}
+
+func TestApplication_withLongestMatch_Success(t *testing.T) {
+	script := `
+		@word;
+		word: lowerA | lowerA lowerB | lowerB lowerA | lowerA lowerB;
+
+		lowerA: 97;
+		lowerB: 98;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	tree, err := applications.NewApplication().Execute(reference.Root(), []byte("ab"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !tree.HasRemaining() {
+		t.Errorf("the first successful line was expected to be selected by default")
+		return
+	}
+
+	grammarApp, err := applications.NewBuilder().Create().IsLongestMatch().Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	tree, err = grammarApp.Execute(reference.Root(), []byte("ab"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if tree.HasRemaining() {
+		t.Errorf("the tree was expected to NOT contain remaining data, remaining: %s", tree.Remaining())
+		return
+	}
+
+	token := tree.Token()
+	if token.Successful().Index() != 1 {
+		t.Errorf("the successful line was expected to be the line (index: %d), index %d returned", 1, token.Successful().Index())
+		return
+	}
+
+	if !token.HasAlternatives() {
+		t.Errorf("the token was expected to contain alternatives")
+		return
+	}
+
+	alternatives := token.Alternatives()
+	if len(alternatives) != 2 || alternatives[0].Index() != 0 || alternatives[1].Index() != 3 {
+		t.Errorf("the alternatives were expected to be the lines (index: 0, 3)")
+		return
+	}
+}
+
+func TestApplication_withAmbiguities_Success(t *testing.T) {
+	script := `
+		@word;
+		word: letters | lowerA lowerB | pair;
+		letters: letter+;
+		pair: lowerA lowerB;
+		letter: lowerA | lowerB | pair;
+
+		lowerA: 97;
+		lowerB: 98;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	ambiguities, err := applications.NewApplication().Ambiguities(reference.Root(), []byte("ab"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(ambiguities) != 1 {
+		t.Errorf("%d ambiguities were expected, %d returned", 1, len(ambiguities))
+		return
+	}
+
+	ambiguity := ambiguities[0]
+	if ambiguity.Token().Name() != "word" {
+		t.Errorf("the ambiguous token was expected to be %s, %s returned", "word", ambiguity.Token().Name())
+		return
+	}
+
+	position := ambiguity.Position()
+	if position.Start().Offset() != 0 || position.End().Offset() != 2 {
+		t.Errorf("the ambiguous span was expected to be [%d, %d], [%d, %d] returned", 0, 2, position.Start().Offset(), position.End().Offset())
+		return
+	}
+
+	lines := ambiguity.Lines()
+	if len(lines) != 3 || lines[0].Index() != 0 || lines[1].Index() != 1 || lines[2].Index() != 2 {
+		t.Errorf("the ambiguous lines were expected to be the lines (index: 0, 1, 2)")
+		return
+	}
+}
+
+func TestApplication_withAmbiguitiesInAlternatives_Success(t *testing.T) {
+	script := `
+		@word;
+		word: letters | pair;
+		letters: lowerA lowerB;
+		pair: lowerA lowerB | lowerA letterB;
+		letterB: lowerB;
+
+		lowerA: 97;
+		lowerB: 98;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// the pair is only derived by the alternative line of the word:
+	ambiguities, err := applications.NewApplication().Ambiguities(reference.Root(), []byte("ab"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	names := []string{}
+	for _, oneAmbiguity := range ambiguities {
+		names = append(names, oneAmbiguity.Token().Name())
+	}
+
+	if strings.Join(names, ",") != "word,pair" {
+		t.Errorf("the ambiguous tokens were expected to be (%s), (%s) returned", "word,pair", strings.Join(names, ","))
+		return
+	}
+}
+
+func TestApplication_withStrict_Success(t *testing.T) {
+	script := `
+		@number;
+		-space;
+
+		number: digit+
+			---
+			valid: one & oneTwo;
+			invalid: onePlus;
+		;
+
+		digit: one | two;
+		oneTwo: one two;
+		onePlus: one plus;
+
+		one: 49;
+		two: 50;
+		plus: 43;
+		space: 32;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	grammarApp := applications.NewApplication()
+	_, err = grammarApp.ExecuteComplete(reference.Root(), []byte("12 "))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = grammarApp.ExecuteComplete(reference.Root(), []byte("12 +"))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	var parseErr applications.ParseError
+	if !errors.As(err, &parseErr) {
+		t.Errorf("the error was expected to be a ParseError")
+		return
+	}
+
+	if parseErr.Location().Offset() != 3 {
+		t.Errorf("the error was expected to be located at offset %d, %d returned", 3, parseErr.Location().Offset())
+		return
+	}
+
+	coverages, err := grammarApp.Coverages(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !coverages.List()[0].Executions().List()[2].Result().IsTree() {
+		t.Errorf("the invalid suite was expected to partially match when not strict")
+		return
+	}
+
+	strictApp, err := applications.NewBuilder().Create().IsStrict().Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	coverages, err = strictApp.Coverages(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	for idx, oneExecution := range coverages.List()[0].Executions().List() {
+		if oneExecution.Expectation().IsValid() != oneExecution.Result().IsTree() {
+			t.Errorf("the execution (index: %d) was not expected to fail", idx)
+			return
+		}
+	}
+}
+
+func TestApplication_withStream_Success(t *testing.T) {
+	script := `
+		@record;
+		-newLine;
+
+		record: letter+ semicolon;
+		letter: lowerA | lowerB;
+
+		lowerA: 97;
+		lowerB: 98;
+		semicolon: 59;
+		newLine: 10;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	grammarApp := applications.NewApplication()
+	reader := iotest.OneByteReader(strings.NewReader("ab;\nba;\n\naab;\nb;"))
+	stream, err := grammarApp.Stream(reference.Root(), reader, 8)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	records := []string{}
+	positions := []trees.Position{}
+	for {
+		tree, err := stream.Next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		records = append(records, string(tree.Bytes(false)))
+		positions = append(positions, tree.Position())
+	}
+
+	expected := []string{"ab;", "ba;", "aab;", "b;"}
+	if strings.Join(records, ",") != strings.Join(expected, ",") {
+		t.Errorf("the records were expected to be %v, %v returned", expected, records)
+		return
+	}
+
+	// the positions are relative to the start of the stream, not to the buffer of each tree:
+	locations := [][]uint{
+		{4, 2, 1},
+		{9, 4, 1},
+	}
+
+	for idx, oneLocation := range locations {
+		start := positions[idx+1].Start()
+		if start.Offset() != oneLocation[0] || start.Line() != oneLocation[1] || start.Column() != oneLocation[2] {
+			t.Errorf("the tree (index: %d) was expected to start at offset %d, %d:%d, offset %d, %d:%d returned", idx+1, oneLocation[0], oneLocation[1], oneLocation[2], start.Offset(), start.Line(), start.Column())
+			return
+		}
+	}
+
+	// the records that end on the end of a read, or are cut across reads, are parsed with the data that follows them:
+	inputs := map[string][]string{
+		"ab;ba;":      {"ab;", "ba;"},
+		"aab;b;":      {"aab;", "b;"},
+		"aabbaabb;a;": {"aabbaabb;", "a;"},
+	}
+
+	for oneInput, oneExpected := range inputs {
+		stream, err = grammarApp.Stream(reference.Root(), iotest.OneByteReader(strings.NewReader(oneInput)), 3)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		records := []string{}
+		for {
+			tree, err := stream.Next()
+			if err == io.EOF {
+				break
+			}
+
+			if err != nil {
+				t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+				return
+			}
+
+			records = append(records, string(tree.Bytes(false)))
+		}
+
+		if strings.Join(records, ",") != strings.Join(oneExpected, ",") {
+			t.Errorf("the records of the input (%s) were expected to be %v, %v returned", oneInput, oneExpected, records)
+			return
+		}
+	}
+
+	stream, err = grammarApp.Stream(reference.Root(), iotest.OneByteReader(strings.NewReader("ab;abc;")), 3)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = stream.Next()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = stream.Next()
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestApplication_withElementsCoverage_Success(t *testing.T) {
+	script := `
+		@expression;
+
+		expression: term plusTerm*
+			---
+			valid: onePlusTwo & two;
+			invalid: open;
+		;
+
+		plusTerm: plus term;
+		term: number | parenthesis;
+		parenthesis: open expression close;
+		number: zero | one | two;
+
+		zero: 48;
+		one: 49;
+		two: 50;
+		plus: 43;
+		open: 40;
+		close: 41;
+		onePlusTwo: one plus two;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	coverages, err := applications.NewApplication().Coverages(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !coverages.HasTokens() {
+		t.Errorf("the coverages were expected to contain tokens")
+		return
+	}
+
+	expected := map[string][]float64{
+		"expression":  {100, 100},
+		"plusTerm":    {100, 100},
+		"term":        {50, 50},
+		"parenthesis": {0, 0},
+		"number":      {float64(2) * 100 / 3, float64(2) * 100 / 3},
+	}
+
+	found := 0
+	for _, oneToken := range coverages.Tokens() {
+		name := oneToken.Reference().Name()
+		percentages, ok := expected[name]
+		if !ok {
+			continue
+		}
+
+		found++
+		if oneToken.LinesPercentage() != percentages[0] || oneToken.ElementsPercentage() != percentages[1] {
+			t.Errorf("the token (name: %s) was expected to have a coverage of %f lines and %f elements, %f and %f returned", name, percentages[0], percentages[1], oneToken.LinesPercentage(), oneToken.ElementsPercentage())
+			return
+		}
+
+		if name == "term" && oneToken.Lines()[1].IsCovered() {
+			t.Errorf("the parenthesis line of the term token was expected to be dead")
+			return
+		}
+	}
+
+	if found != len(expected) {
+		t.Errorf("%d tokens were expected, %d found", len(expected), found)
+		return
+	}
+}
+
+func TestApplication_withPassedExecutions_Success(t *testing.T) {
+	script := `
+		@number;
+
+		number: digit+
+			---
+			valid: oneTwo & oneTwoPlus;
+			invalid: plus & onePlus;
+		;
+
+		digit: one | two;
+		one: 49;
+		two: 50;
+		plus: 43;
+		oneTwo: one two;
+		oneTwoPlus: one two plus;
+		onePlus: one plus;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	coverages, err := applications.NewApplication().Coverages(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// the remaining data fails the valid suite and passes the invalid one:
+	expected := []bool{true, false, true, true}
+	executionsList := coverages.List()[0].Executions().List()
+	for idx, oneExecution := range executionsList {
+		if oneExecution.Passed() != expected[idx] {
+			t.Errorf("the execution (index: %d) was expected to return %t on Passed", idx, expected[idx])
+			return
+		}
+	}
+
+	if coverages.Passed() != 3 || coverages.Failed() != 1 || !coverages.ContainsError() {
+		t.Errorf("the coverages were expected to contain %d passed and %d failed executions, %d and %d returned", 3, 1, coverages.Passed(), coverages.Failed())
+		return
+	}
+}
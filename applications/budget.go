@@ -0,0 +1,74 @@
+package applications
+
+import "context"
+
+type budget struct {
+	ctx    context.Context
+	limits Limits
+	steps  uint
+	depth  uint
+	nodes  uint
+	err    error
+}
+
+func createBudget(
+	ctx context.Context,
+	limits Limits,
+) *budget {
+	out := budget{
+		ctx:    ctx,
+		limits: limits,
+		steps:  0,
+		depth:  0,
+		nodes:  0,
+		err:    nil,
+	}
+
+	return &out
+}
+
+// step accounts for a token parsing step, and returns an error if the parsing must stop
+func (obj *budget) step() error {
+	if obj.err != nil {
+		return obj.err
+	}
+
+	select {
+	case <-obj.ctx.Done():
+		obj.err = obj.ctx.Err()
+		return obj.err
+	default:
+	}
+
+	obj.steps++
+	if obj.limits == nil {
+		return nil
+	}
+
+	if obj.limits.HasSteps() && obj.steps > *obj.limits.Steps() {
+		obj.err = createLimitError(LimitSteps, *obj.limits.Steps())
+		return obj.err
+	}
+
+	if obj.limits.HasDepth() && obj.depth >= *obj.limits.Depth() {
+		obj.err = createLimitError(LimitDepth, *obj.limits.Depth())
+		return obj.err
+	}
+
+	return nil
+}
+
+// node accounts for a built tree node, and returns an error if the parsing must stop
+func (obj *budget) node() error {
+	obj.nodes++
+	if obj.limits == nil || !obj.limits.HasNodes() {
+		return nil
+	}
+
+	if obj.nodes > *obj.limits.Nodes() {
+		obj.err = createLimitError(LimitNodes, *obj.limits.Nodes())
+		return obj.err
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package applications
+
+type builder struct {
+	pMemoLimit     *uint
+	isLongestMatch bool
+	isStrict       bool
+}
+
+func createBuilder() Builder {
+	out := builder{
+		pMemoLimit:     nil,
+		isLongestMatch: false,
+		isStrict:       false,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *builder) Create() Builder {
+	return createBuilder()
+}
+
+// WithMemoLimit adds a memo limit to the builder, a limit of zero disables the memo
+func (app *builder) WithMemoLimit(memoLimit uint) Builder {
+	app.pMemoLimit = &memoLimit
+	return app
+}
+
+// IsLongestMatch flags the builder as selecting the line that consumes the most data, instead of the first successful line
+func (app *builder) IsLongestMatch() Builder {
+	app.isLongestMatch = true
+	return app
+}
+
+// IsStrict flags the builder as failing the executions that do not consume the whole input
+func (app *builder) IsStrict() Builder {
+	app.isStrict = true
+	return app
+}
+
+// Now builds a new Application instance
+func (app *builder) Now() (Application, error) {
+	return newApplication(app.pMemoLimit, app.isLongestMatch, app.isStrict), nil
+}
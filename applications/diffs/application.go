@@ -0,0 +1,324 @@
+package diffs
+
+import (
+	"fmt"
+	"strings"
+
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/references"
+)
+
+type application struct {
+}
+
+type named struct {
+	names  []string
+	tokens map[string]grammars.Token
+}
+
+func createApplication() Application {
+	out := application{}
+	return &out
+}
+
+// Diff returns the structural differences between two references, where tokens are compared by name so that a token whose hash only changes because of its dependencies is not reported
+func (app *application) Diff(from references.Reference, to references.Reference) Diff {
+	fromTokens := app.named(from)
+	toTokens := app.named(to)
+	tokens := []Token{}
+	for _, oneName := range toTokens.names {
+		toToken := toTokens.tokens[oneName]
+		fromToken, ok := fromTokens.tokens[oneName]
+		if !ok {
+			tokens = append(tokens, createTokenAdded(oneName, toToken))
+			continue
+		}
+
+		if fromToken.Hash().Compare(toToken.Hash()) {
+			continue
+		}
+
+		lines := app.lines(from, to, fromToken.Lines(), toToken.Lines())
+		suites := app.suites(fromToken, toToken)
+		if len(lines) <= 0 && len(suites) <= 0 {
+			continue
+		}
+
+		tokens = append(tokens, createTokenChanged(oneName, fromToken, toToken, lines, suites))
+	}
+
+	for _, oneName := range fromTokens.names {
+		if _, ok := toTokens.tokens[oneName]; ok {
+			continue
+		}
+
+		tokens = append(tokens, createTokenRemoved(oneName, fromTokens.tokens[oneName]))
+	}
+
+	isRootChanged := from.Tokens().Name(from.Root().Root()) != to.Tokens().Name(to.Root().Root())
+	channels := app.channels(from, to)
+	return createDiff(from, to, isRootChanged, tokens, channels)
+}
+
+func (app *application) named(reference references.Reference) named {
+	output := named{
+		names:  []string{},
+		tokens: map[string]grammars.Token{},
+	}
+
+	for _, oneToken := range reference.Tokens().List() {
+		name := oneToken.Name()
+		if _, ok := output.tokens[name]; ok {
+			continue
+		}
+
+		output.names = append(output.names, name)
+		output.tokens[name] = oneToken.Reference()
+	}
+
+	return output
+}
+
+// lines aligns the lines on their longest common subsequence, then pairs the remaining lines of each gap as changed lines
+func (app *application) lines(fromReference references.Reference, toReference references.Reference, from []grammars.Line, to []grammars.Line) []Line {
+	fromSignatures := []string{}
+	for _, oneLine := range from {
+		fromSignatures = append(fromSignatures, app.lineSignature(fromReference, oneLine))
+	}
+
+	toSignatures := []string{}
+	for _, oneLine := range to {
+		toSignatures = append(toSignatures, app.lineSignature(toReference, oneLine))
+	}
+
+	table := make([][]int, len(from)+1)
+	for idx := range table {
+		table[idx] = make([]int, len(to)+1)
+	}
+
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if fromSignatures[i] == toSignatures[j] {
+				table[i][j] = table[i+1][j+1] + 1
+				continue
+			}
+
+			table[i][j] = table[i+1][j]
+			if table[i][j+1] > table[i][j] {
+				table[i][j] = table[i][j+1]
+			}
+		}
+	}
+
+	output := []Line{}
+	removed := []int{}
+	added := []int{}
+	flush := func() {
+		for idx := 0; idx < len(removed) || idx < len(added); idx++ {
+			if idx < len(removed) && idx < len(added) {
+				fromLine := from[removed[idx]]
+				toLine := to[added[idx]]
+				elements := app.elements(fromReference, toReference, fromLine.Elements(), toLine.Elements())
+				output = append(output, createLineChanged(uint(added[idx]), fromLine, toLine, elements))
+				continue
+			}
+
+			if idx < len(removed) {
+				output = append(output, createLineRemoved(uint(removed[idx]), from[removed[idx]]))
+				continue
+			}
+
+			output = append(output, createLineAdded(uint(added[idx]), to[added[idx]]))
+		}
+
+		removed = []int{}
+		added = []int{}
+	}
+
+	i := 0
+	j := 0
+	for i < len(from) || j < len(to) {
+		if i < len(from) && j < len(to) && fromSignatures[i] == toSignatures[j] {
+			flush()
+			i++
+			j++
+			continue
+		}
+
+		if j >= len(to) || (i < len(from) && table[i+1][j] >= table[i][j+1]) {
+			removed = append(removed, i)
+			i++
+			continue
+		}
+
+		added = append(added, j)
+		j++
+	}
+
+	flush()
+	return output
+}
+
+func (app *application) elements(fromReference references.Reference, toReference references.Reference, from []grammars.Element, to []grammars.Element) []Element {
+	output := []Element{}
+	for idx := 0; idx < len(from) || idx < len(to); idx++ {
+		if idx >= len(from) {
+			output = append(output, createElementAdded(uint(idx), to[idx]))
+			continue
+		}
+
+		if idx >= len(to) {
+			output = append(output, createElementRemoved(uint(idx), from[idx]))
+			continue
+		}
+
+		isContentChanged := app.contentSignature(fromReference, from[idx].Content()) != app.contentSignature(toReference, to[idx].Content())
+		isCardinalityChanged := app.cardinalitySignature(from[idx].Cardinality()) != app.cardinalitySignature(to[idx].Cardinality())
+		if !isContentChanged && !isCardinalityChanged {
+			continue
+		}
+
+		output = append(output, createElementChanged(uint(idx), from[idx], to[idx], isContentChanged, isCardinalityChanged))
+	}
+
+	return output
+}
+
+func (app *application) suites(from grammars.Token, to grammars.Token) []Suite {
+	fromSuites := map[string]bool{}
+	if from.HasSuites() {
+		for _, oneSuite := range from.Suites() {
+			fromSuites[app.suiteSignature(oneSuite)] = true
+		}
+	}
+
+	toSuites := map[string]bool{}
+	output := []Suite{}
+	if to.HasSuites() {
+		for _, oneSuite := range to.Suites() {
+			signature := app.suiteSignature(oneSuite)
+			toSuites[signature] = true
+			if !fromSuites[signature] {
+				output = append(output, createSuite(KindAdded, oneSuite))
+			}
+		}
+	}
+
+	if from.HasSuites() {
+		for _, oneSuite := range from.Suites() {
+			if !toSuites[app.suiteSignature(oneSuite)] {
+				output = append(output, createSuite(KindRemoved, oneSuite))
+			}
+		}
+	}
+
+	return output
+}
+
+func (app *application) channels(from references.Reference, to references.Reference) []Channel {
+	fromChannels := map[string]grammars.Channel{}
+	fromNames := []string{}
+	if from.Root().HasChannels() {
+		for _, oneChannel := range from.Root().Channels() {
+			name := from.Tokens().Name(oneChannel.Token())
+			fromChannels[name] = oneChannel
+			fromNames = append(fromNames, name)
+		}
+	}
+
+	toChannels := map[string]grammars.Channel{}
+	output := []Channel{}
+	if to.Root().HasChannels() {
+		for _, oneChannel := range to.Root().Channels() {
+			name := to.Tokens().Name(oneChannel.Token())
+			toChannels[name] = oneChannel
+			fromChannel, ok := fromChannels[name]
+			if !ok {
+				output = append(output, createChannelAdded(name, oneChannel))
+				continue
+			}
+
+			if app.conditionSignature(from, fromChannel) != app.conditionSignature(to, oneChannel) {
+				output = append(output, createChannelChanged(name, fromChannel, oneChannel))
+			}
+		}
+	}
+
+	for _, oneName := range fromNames {
+		if _, ok := toChannels[oneName]; ok {
+			continue
+		}
+
+		output = append(output, createChannelRemoved(oneName, fromChannels[oneName]))
+	}
+
+	return output
+}
+
+func (app *application) lineSignature(reference references.Reference, line grammars.Line) string {
+	elements := []string{}
+	for _, oneElement := range line.Elements() {
+		signature := fmt.Sprintf("%s%s", app.contentSignature(reference, oneElement.Content()), app.cardinalitySignature(oneElement.Cardinality()))
+		elements = append(elements, signature)
+	}
+
+	return strings.Join(elements, " ")
+}
+
+func (app *application) contentSignature(reference references.Reference, content grammars.ElementContent) string {
+	if content.IsValue() {
+		return fmt.Sprintf("value:%v", content.Value())
+	}
+
+	if content.IsGrammar() {
+		return fmt.Sprintf("grammar:%s", reference.Tokens().Name(content.Grammar().Root()))
+	}
+
+	if content.IsRecursive() {
+		return fmt.Sprintf("recursive:%s", content.Recursive())
+	}
+
+	instance := content.Instance()
+	if instance.IsToken() {
+		return fmt.Sprintf("token:%s", reference.Tokens().Name(instance.Token()))
+	}
+
+	everything := instance.Everything()
+	escape := ""
+	if everything.HasEscape() {
+		escape = reference.Tokens().Name(everything.Escape())
+	}
+
+	return fmt.Sprintf("everything:%s!%s", reference.Tokens().Name(everything.Exception()), escape)
+}
+
+func (app *application) cardinalitySignature(cardinality grammars.Cardinality) string {
+	if !cardinality.HasMax() {
+		return fmt.Sprintf("[%d,]", cardinality.Min())
+	}
+
+	return fmt.Sprintf("[%d,%d]", cardinality.Min(), *cardinality.Max())
+}
+
+func (app *application) suiteSignature(suite grammars.Suite) string {
+	return fmt.Sprintf("%t:%v", suite.IsValid(), suite.Content())
+}
+
+func (app *application) conditionSignature(reference references.Reference, channel grammars.Channel) string {
+	if !channel.HasCondition() {
+		return ""
+	}
+
+	previous := ""
+	next := ""
+	condition := channel.Condition()
+	if condition.HasPrevious() {
+		previous = reference.Tokens().Name(condition.Previous())
+	}
+
+	if condition.HasNext() {
+		next = reference.Tokens().Name(condition.Next())
+	}
+
+	return fmt.Sprintf("%s:%s", previous, next)
+}
@@ -0,0 +1,126 @@
+package diffs_test
+
+import (
+	"testing"
+
+	"github.com/steve-care-software/grammars/applications/diffs"
+	"github.com/steve-care-software/grammars/infrastructure/scripts"
+)
+
+func TestDiff_Success(t *testing.T) {
+	from := `
+		@expression;
+		-space;
+		-newLine [space:space];
+		expression: number plusNumber*
+			---
+			valid: one;
+		;
+
+		plusNumber: plus number;
+		number: one | two;
+		one: 49;
+		two: 50;
+		plus: 43;
+		space: 32;
+		newLine: 10;
+	`
+
+	to := `
+		@expression;
+		-space;
+		-newLine [space];
+		-tab;
+		expression: number plusNumber+
+			---
+			valid: one & onePlusTwo;
+		;
+
+		plusNumber: minus number | plus number;
+		number: zero | one | two;
+		zero: 48;
+		one: 49;
+		two: 50;
+		plus: 43;
+		minus: 45;
+		space: 32;
+		newLine: 10;
+		tab: 9;
+		onePlusTwo: one plus two;
+	`
+
+	compiler := scripts.NewCompiler()
+	fromReference, err := compiler.Compile([]byte(from))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	toReference, err := compiler.Compile([]byte(to))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	diffApp := diffs.NewApplication()
+	if !diffApp.Diff(fromReference, fromReference).IsEmpty() {
+		t.Errorf("the diff of a reference with itself was expected to be empty")
+		return
+	}
+
+	diff := diffApp.Diff(fromReference, toReference)
+	if diff.IsEmpty() || diff.IsRootChanged() {
+		t.Errorf("the diff was expected to contain changes, without changing its root")
+		return
+	}
+
+	tokens := map[string]diffs.Token{}
+	for _, oneToken := range diff.Tokens() {
+		tokens[oneToken.Name()] = oneToken
+	}
+
+	if len(tokens) != 4 || tokens["tab"].Kind() != diffs.KindAdded {
+		t.Errorf("the diff was expected to contain 4 tokens, including the added tab, %d returned", len(tokens))
+		return
+	}
+
+	plusNumber := tokens["plusNumber"].Lines()
+	if len(plusNumber) != 1 || plusNumber[0].Kind() != diffs.KindAdded || plusNumber[0].Index() != 0 {
+		t.Errorf("the plusNumber token was expected to only contain its added first line")
+		return
+	}
+
+	expression := tokens["expression"]
+	lines := expression.Lines()
+	if len(lines) != 1 || lines[0].Kind() != diffs.KindChanged {
+		t.Errorf("the expression token was expected to contain 1 changed line")
+		return
+	}
+
+	elements := lines[0].Elements()
+	if len(elements) != 1 || elements[0].Index() != 1 || !elements[0].IsCardinalityChanged() || elements[0].IsContentChanged() {
+		t.Errorf("the expression line was expected to only contain the modified cardinality of its second element")
+		return
+	}
+
+	suites := expression.Suites()
+	if len(suites) != 1 || suites[0].Kind() != diffs.KindAdded || string(suites[0].Suite().Content()) != "1+2" {
+		t.Errorf("the expression token was expected to contain 1 added suite")
+		return
+	}
+
+	if _, ok := tokens["one"]; ok {
+		t.Errorf("the one token was not expected to be changed")
+		return
+	}
+
+	channels := map[string]uint8{}
+	for _, oneChannel := range diff.Channels() {
+		channels[oneChannel.Name()] = oneChannel.Kind()
+	}
+
+	if len(channels) != 2 || channels["tab"] != diffs.KindAdded || channels["newLine"] != diffs.KindChanged {
+		t.Errorf("the diff was expected to contain the added tab channel and the changed newLine channel: %v", channels)
+		return
+	}
+}
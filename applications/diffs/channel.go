@@ -0,0 +1,80 @@
+package diffs
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+type channel struct {
+	kind uint8
+	name string
+	from grammars.Channel
+	to   grammars.Channel
+}
+
+func createChannelAdded(
+	name string,
+	to grammars.Channel,
+) Channel {
+	return createChannelInternally(KindAdded, name, nil, to)
+}
+
+func createChannelRemoved(
+	name string,
+	from grammars.Channel,
+) Channel {
+	return createChannelInternally(KindRemoved, name, from, nil)
+}
+
+func createChannelChanged(
+	name string,
+	from grammars.Channel,
+	to grammars.Channel,
+) Channel {
+	return createChannelInternally(KindChanged, name, from, to)
+}
+
+func createChannelInternally(
+	kind uint8,
+	name string,
+	from grammars.Channel,
+	to grammars.Channel,
+) Channel {
+	out := channel{
+		kind: kind,
+		name: name,
+		from: from,
+		to:   to,
+	}
+
+	return &out
+}
+
+// Kind returns the kind of difference
+func (obj *channel) Kind() uint8 {
+	return obj.kind
+}
+
+// Name returns the name of the token of the channel
+func (obj *channel) Name() string {
+	return obj.name
+}
+
+// HasFrom returns true if the channel exists in the old grammar, false otherwise
+func (obj *channel) HasFrom() bool {
+	return obj.from != nil
+}
+
+// From returns the channel of the old grammar, if any
+func (obj *channel) From() grammars.Channel {
+	return obj.from
+}
+
+// HasTo returns true if the channel exists in the new grammar, false otherwise
+func (obj *channel) HasTo() bool {
+	return obj.to != nil
+}
+
+// To returns the channel of the new grammar, if any
+func (obj *channel) To() grammars.Channel {
+	return obj.to
+}
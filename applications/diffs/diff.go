@@ -0,0 +1,61 @@
+package diffs
+
+import (
+	"github.com/steve-care-software/grammars/domain/references"
+)
+
+type diff struct {
+	from          references.Reference
+	to            references.Reference
+	isRootChanged bool
+	tokens        []Token
+	channels      []Channel
+}
+
+func createDiff(
+	from references.Reference,
+	to references.Reference,
+	isRootChanged bool,
+	tokens []Token,
+	channels []Channel,
+) Diff {
+	out := diff{
+		from:          from,
+		to:            to,
+		isRootChanged: isRootChanged,
+		tokens:        tokens,
+		channels:      channels,
+	}
+
+	return &out
+}
+
+// From returns the old reference
+func (obj *diff) From() references.Reference {
+	return obj.from
+}
+
+// To returns the new reference
+func (obj *diff) To() references.Reference {
+	return obj.to
+}
+
+// IsEmpty returns true if the references are structurally equal, false otherwise
+func (obj *diff) IsEmpty() bool {
+	return !obj.isRootChanged && len(obj.tokens) <= 0 && len(obj.channels) <= 0
+}
+
+// IsRootChanged returns true if the root token is renamed, false otherwise
+func (obj *diff) IsRootChanged() bool {
+	return obj.isRootChanged
+}
+
+// Tokens returns the token differences
+func (obj *diff) Tokens() []Token {
+	return obj.tokens
+}
+
+// Channels returns the channel differences
+func (obj *diff) Channels() []Channel {
+	return obj.channels
+}
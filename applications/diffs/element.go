@@ -0,0 +1,98 @@
+package diffs
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+type element struct {
+	kind                 uint8
+	index                uint
+	from                 grammars.Element
+	to                   grammars.Element
+	isContentChanged     bool
+	isCardinalityChanged bool
+}
+
+func createElementAdded(
+	index uint,
+	to grammars.Element,
+) Element {
+	return createElementInternally(KindAdded, index, nil, to, false, false)
+}
+
+func createElementRemoved(
+	index uint,
+	from grammars.Element,
+) Element {
+	return createElementInternally(KindRemoved, index, from, nil, false, false)
+}
+
+func createElementChanged(
+	index uint,
+	from grammars.Element,
+	to grammars.Element,
+	isContentChanged bool,
+	isCardinalityChanged bool,
+) Element {
+	return createElementInternally(KindChanged, index, from, to, isContentChanged, isCardinalityChanged)
+}
+
+func createElementInternally(
+	kind uint8,
+	index uint,
+	from grammars.Element,
+	to grammars.Element,
+	isContentChanged bool,
+	isCardinalityChanged bool,
+) Element {
+	out := element{
+		kind:                 kind,
+		index:                index,
+		from:                 from,
+		to:                   to,
+		isContentChanged:     isContentChanged,
+		isCardinalityChanged: isCardinalityChanged,
+	}
+
+	return &out
+}
+
+// Kind returns the kind of difference
+func (obj *element) Kind() uint8 {
+	return obj.kind
+}
+
+// Index returns the index of the element in its line
+func (obj *element) Index() uint {
+	return obj.index
+}
+
+// HasFrom returns true if the element exists in the old line, false otherwise
+func (obj *element) HasFrom() bool {
+	return obj.from != nil
+}
+
+// From returns the element of the old line, if any
+func (obj *element) From() grammars.Element {
+	return obj.from
+}
+
+// HasTo returns true if the element exists in the new line, false otherwise
+func (obj *element) HasTo() bool {
+	return obj.to != nil
+}
+
+// To returns the element of the new line, if any
+func (obj *element) To() grammars.Element {
+	return obj.to
+}
+
+// IsContentChanged returns true if the content of a changed element differs, false otherwise
+func (obj *element) IsContentChanged() bool {
+	return obj.isContentChanged
+}
+
+// IsCardinalityChanged returns true if the cardinality of a changed element differs, false otherwise
+func (obj *element) IsCardinalityChanged() bool {
+	return obj.isCardinalityChanged
+}
@@ -0,0 +1,89 @@
+package diffs
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+type line struct {
+	kind     uint8
+	index    uint
+	from     grammars.Line
+	to       grammars.Line
+	elements []Element
+}
+
+func createLineAdded(
+	index uint,
+	to grammars.Line,
+) Line {
+	return createLineInternally(KindAdded, index, nil, to, []Element{})
+}
+
+func createLineRemoved(
+	index uint,
+	from grammars.Line,
+) Line {
+	return createLineInternally(KindRemoved, index, from, nil, []Element{})
+}
+
+func createLineChanged(
+	index uint,
+	from grammars.Line,
+	to grammars.Line,
+	elements []Element,
+) Line {
+	return createLineInternally(KindChanged, index, from, to, elements)
+}
+
+func createLineInternally(
+	kind uint8,
+	index uint,
+	from grammars.Line,
+	to grammars.Line,
+	elements []Element,
+) Line {
+	out := line{
+		kind:     kind,
+		index:    index,
+		from:     from,
+		to:       to,
+		elements: elements,
+	}
+
+	return &out
+}
+
+// Kind returns the kind of difference
+func (obj *line) Kind() uint8 {
+	return obj.kind
+}
+
+// Index returns the index of the line in the new token, or in the old token when removed
+func (obj *line) Index() uint {
+	return obj.index
+}
+
+// HasFrom returns true if the line exists in the old token, false otherwise
+func (obj *line) HasFrom() bool {
+	return obj.from != nil
+}
+
+// From returns the line of the old token, if any
+func (obj *line) From() grammars.Line {
+	return obj.from
+}
+
+// HasTo returns true if the line exists in the new token, false otherwise
+func (obj *line) HasTo() bool {
+	return obj.to != nil
+}
+
+// To returns the line of the new token, if any
+func (obj *line) To() grammars.Line {
+	return obj.to
+}
+
+// Elements returns the element differences of a changed line
+func (obj *line) Elements() []Element {
+	return obj.elements
+}
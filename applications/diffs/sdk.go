@@ -0,0 +1,86 @@
+package diffs
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/references"
+)
+
+// KindAdded represents a part that only exists in the new reference
+const KindAdded uint8 = 0
+
+// KindRemoved represents a part that only exists in the old reference
+const KindRemoved uint8 = 1
+
+// KindChanged represents a part that exists in both references with a different structure
+const KindChanged uint8 = 2
+
+// NewApplication creates a new diff application
+func NewApplication() Application {
+	return createApplication()
+}
+
+// Application represents a diff application
+type Application interface {
+	Diff(from references.Reference, to references.Reference) Diff
+}
+
+// Diff represents the structural differences between two references
+type Diff interface {
+	From() references.Reference
+	To() references.Reference
+	IsEmpty() bool
+	IsRootChanged() bool
+	Tokens() []Token
+	Channels() []Channel
+}
+
+// Token represents a token difference, matched by name
+type Token interface {
+	Kind() uint8
+	Name() string
+	HasFrom() bool
+	From() grammars.Token
+	HasTo() bool
+	To() grammars.Token
+	Lines() []Line
+	Suites() []Suite
+}
+
+// Line represents a line difference of a changed token
+type Line interface {
+	Kind() uint8
+	Index() uint
+	HasFrom() bool
+	From() grammars.Line
+	HasTo() bool
+	To() grammars.Line
+	Elements() []Element
+}
+
+// Element represents an element difference of a changed line
+type Element interface {
+	Kind() uint8
+	Index() uint
+	HasFrom() bool
+	From() grammars.Element
+	HasTo() bool
+	To() grammars.Element
+	IsContentChanged() bool
+	IsCardinalityChanged() bool
+}
+
+// Suite represents a test suite added to, or removed from, a changed token
+type Suite interface {
+	Kind() uint8
+	Suite() grammars.Suite
+}
+
+// Channel represents a channel difference of the root grammar, matched by the name of its token
+type Channel interface {
+	Kind() uint8
+	Name() string
+	HasFrom() bool
+	From() grammars.Channel
+	HasTo() bool
+	To() grammars.Channel
+}
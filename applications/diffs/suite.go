@@ -0,0 +1,32 @@
+package diffs
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+type suite struct {
+	kind  uint8
+	suite grammars.Suite
+}
+
+func createSuite(
+	kind uint8,
+	suiteIns grammars.Suite,
+) Suite {
+	out := suite{
+		kind:  kind,
+		suite: suiteIns,
+	}
+
+	return &out
+}
+
+// Kind returns the kind of difference
+func (obj *suite) Kind() uint8 {
+	return obj.kind
+}
+
+// Suite returns the suite
+func (obj *suite) Suite() grammars.Suite {
+	return obj.suite
+}
@@ -0,0 +1,98 @@
+package diffs
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+type token struct {
+	kind   uint8
+	name   string
+	from   grammars.Token
+	to     grammars.Token
+	lines  []Line
+	suites []Suite
+}
+
+func createTokenAdded(
+	name string,
+	to grammars.Token,
+) Token {
+	return createTokenInternally(KindAdded, name, nil, to, []Line{}, []Suite{})
+}
+
+func createTokenRemoved(
+	name string,
+	from grammars.Token,
+) Token {
+	return createTokenInternally(KindRemoved, name, from, nil, []Line{}, []Suite{})
+}
+
+func createTokenChanged(
+	name string,
+	from grammars.Token,
+	to grammars.Token,
+	lines []Line,
+	suites []Suite,
+) Token {
+	return createTokenInternally(KindChanged, name, from, to, lines, suites)
+}
+
+func createTokenInternally(
+	kind uint8,
+	name string,
+	from grammars.Token,
+	to grammars.Token,
+	lines []Line,
+	suites []Suite,
+) Token {
+	out := token{
+		kind:   kind,
+		name:   name,
+		from:   from,
+		to:     to,
+		lines:  lines,
+		suites: suites,
+	}
+
+	return &out
+}
+
+// Kind returns the kind of difference
+func (obj *token) Kind() uint8 {
+	return obj.kind
+}
+
+// Name returns the name of the token
+func (obj *token) Name() string {
+	return obj.name
+}
+
+// HasFrom returns true if the token exists in the old reference, false otherwise
+func (obj *token) HasFrom() bool {
+	return obj.from != nil
+}
+
+// From returns the token of the old reference, if any
+func (obj *token) From() grammars.Token {
+	return obj.from
+}
+
+// HasTo returns true if the token exists in the new reference, false otherwise
+func (obj *token) HasTo() bool {
+	return obj.to != nil
+}
+
+// To returns the token of the new reference, if any
+func (obj *token) To() grammars.Token {
+	return obj.to
+}
+
+// Lines returns the line differences of a changed token
+func (obj *token) Lines() []Line {
+	return obj.lines
+}
+
+// Suites returns the added and removed suites of a changed token
+func (obj *token) Suites() []Suite {
+	return obj.suites
+}
@@ -0,0 +1,287 @@
+package applications
+
+import (
+	"context"
+	"sort"
+
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/trees"
+)
+
+type execution struct {
+	input        []byte
+	origin       trees.Location
+	isSilent     bool
+	isExhaustive bool
+	frames       []frame
+	furthest     uint
+	failures     [][]frame
+	memo         *memo
+	budget       *budget
+	lines        *lines
+}
+
+type lines struct {
+	newLines  []uint
+	isIndexed bool
+}
+
+type frame struct {
+	token   grammars.Token
+	line    uint
+	element uint
+}
+
+type memo struct {
+	pLimit     *uint
+	entries    map[memoKey]memoEntry
+	seeds      map[memoKey]*seed
+	recursives map[string][]string
+	guards     uint
+}
+
+type memoKey struct {
+	token     string
+	escape    string
+	channels  string
+	context   string
+	isReverse bool
+	isSilent  bool
+	previous  uint
+	offset    uint
+}
+
+type memoEntry struct {
+	tree trees.Tree
+	err  error
+}
+
+type seed struct {
+	tree trees.Tree
+	hits uint
+}
+
+func createExecution(input []byte, pMemoLimit *uint) *execution {
+	out := execution{
+		input:        input,
+		origin:       nil,
+		isSilent:     false,
+		isExhaustive: false,
+		frames:       []frame{},
+		furthest:     0,
+		failures:     [][]frame{},
+		memo: &memo{
+			pLimit:     pMemoLimit,
+			entries:    map[memoKey]memoEntry{},
+			seeds:      map[memoKey]*seed{},
+			recursives: map[string][]string{},
+			guards:     0,
+		},
+		budget: createBudget(context.Background(), nil),
+		lines:  &lines{},
+	}
+
+	return &out
+}
+
+// silent returns an execution on the same input that does not record failures, sharing the same memo
+func (obj *execution) silent() *execution {
+	out := createExecution(obj.input, obj.memo.pLimit)
+	out.origin = obj.origin
+	out.isSilent = true
+	out.isExhaustive = obj.isExhaustive
+	out.memo = obj.memo
+	out.budget = obj.budget
+	out.lines = obj.lines
+	return out
+}
+
+// detached returns a silent execution on another input, sharing the same budget
+func (obj *execution) detached(input []byte) *execution {
+	out := createExecution(input, obj.memo.pLimit).silent()
+	out.budget = obj.budget
+	return out
+}
+
+// newLines returns the offsets of the new lines of the input, searched once and shared with the silent executions
+func (obj *execution) newLines() []uint {
+	if !obj.lines.isIndexed {
+		obj.lines.newLines = trees.NewLines(obj.input)
+		obj.lines.isIndexed = true
+	}
+
+	return obj.lines.newLines
+}
+
+// memoKey returns the key of a token parsed on the given data, where the context identifies the enclosing tokens its recursive names resolve to
+func (obj *execution) memoKey(token grammars.Token, escape grammars.Token, channels []grammars.Channel, context string, isReverse bool, prevData []byte, currentData []byte) memoKey {
+	out := memoKey{
+		token:     string(token.Hash()),
+		context:   context,
+		isReverse: isReverse,
+		isSilent:  obj.isSilent,
+		previous:  uint(len(prevData)),
+		offset:    obj.offset(currentData),
+	}
+
+	if escape != nil {
+		out.escape = string(escape.Hash())
+	}
+
+	if len(channels) > 0 {
+		hashes := []byte{}
+		for _, oneChannel := range channels {
+			hashes = append(hashes, oneChannel.Hash()...)
+		}
+
+		out.channels = string(hashes)
+	}
+
+	return out
+}
+
+// recursives returns the sorted recursive names reached from the token that the token does not bind itself
+func (obj *execution) recursives(token grammars.Token) []string {
+	keyname := string(token.Hash())
+	if names, ok := obj.memo.recursives[keyname]; ok {
+		return names
+	}
+
+	found := map[string]bool{}
+	for _, oneLine := range token.Lines() {
+		for _, oneElement := range oneLine.Elements() {
+			content := oneElement.Content()
+			if content.IsRecursive() {
+				found[content.Recursive()] = true
+				continue
+			}
+
+			if !content.IsInstance() {
+				continue
+			}
+
+			// the instances can only reference tokens that already exist, therefore they never loop:
+			instance := content.Instance()
+			children := []grammars.Token{}
+			if instance.IsToken() {
+				children = append(children, instance.Token())
+			} else {
+				everything := instance.Everything()
+				children = append(children, everything.Exception())
+				if everything.HasEscape() {
+					children = append(children, everything.Escape())
+				}
+			}
+
+			for _, oneChild := range children {
+				for _, oneName := range obj.recursives(oneChild) {
+					found[oneName] = true
+				}
+			}
+		}
+	}
+
+	if token.HasName() {
+		delete(found, token.Name())
+	}
+
+	names := []string{}
+	for oneName := range found {
+		names = append(names, oneName)
+	}
+
+	sort.Strings(names)
+	obj.memo.recursives[keyname] = names
+	return names
+}
+
+// fetch returns the memoized result of the key, if any
+func (obj *execution) fetch(key memoKey) (memoEntry, bool) {
+	entry, ok := obj.memo.entries[key]
+	return entry, ok
+}
+
+// remember memoizes the result of the key, the memo is cleared once its limit is reached
+func (obj *execution) remember(key memoKey, tree trees.Tree, err error) {
+	if obj.memo.pLimit != nil {
+		limit := int(*obj.memo.pLimit)
+		if limit <= 0 {
+			return
+		}
+
+		if len(obj.memo.entries) >= limit {
+			obj.memo.entries = map[memoKey]memoEntry{}
+		}
+	}
+
+	obj.memo.entries[key] = memoEntry{
+		tree: tree,
+		err:  err,
+	}
+}
+
+// offset returns the offset of the data in the input
+func (obj *execution) offset(data []byte) uint {
+	return uint(len(obj.input) - len(data))
+}
+
+func (obj *execution) enter(token grammars.Token) {
+	obj.frames = append(obj.frames, frame{
+		token: token,
+	})
+}
+
+func (obj *execution) leave() {
+	obj.frames = obj.frames[:len(obj.frames)-1]
+}
+
+func (obj *execution) line(index uint) {
+	obj.frames[len(obj.frames)-1].line = index
+}
+
+func (obj *execution) element(index uint) {
+	obj.frames[len(obj.frames)-1].element = index
+}
+
+// fail records the current frames as expected at the position of the data, if it is the furthest position reached
+func (obj *execution) fail(data []byte) {
+	if obj.isSilent || len(obj.frames) <= 0 {
+		return
+	}
+
+	offset := obj.offset(data)
+	if offset < obj.furthest {
+		return
+	}
+
+	if offset > obj.furthest || len(obj.failures) <= 0 {
+		obj.furthest = offset
+		obj.failures = [][]frame{}
+	}
+
+	frames := make([]frame, len(obj.frames))
+	copy(frames, obj.frames)
+	obj.failures = append(obj.failures, frames)
+}
+
+// seed returns the seed of the token being parsed on the key, if any
+func (obj *execution) seed(key memoKey) (*seed, bool) {
+	ins, ok := obj.memo.seeds[key]
+	return ins, ok
+}
+
+// plant adds an empty seed on the key, that grows when the token is left recursive
+func (obj *execution) plant(key memoKey) *seed {
+	ins := &seed{
+		tree: nil,
+		hits: 0,
+	}
+
+	obj.memo.seeds[key] = ins
+	return ins
+}
+
+// harvest removes the seed of the key
+func (obj *execution) harvest(key memoKey) {
+	delete(obj.memo.seeds, key)
+}
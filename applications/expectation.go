@@ -0,0 +1,48 @@
+package applications
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+type expectation struct {
+	token   grammars.Token
+	line    uint
+	element grammars.Element
+	parents []grammars.Token
+}
+
+func createExpectation(
+	token grammars.Token,
+	line uint,
+	element grammars.Element,
+	parents []grammars.Token,
+) Expectation {
+	out := expectation{
+		token:   token,
+		line:    line,
+		element: element,
+		parents: parents,
+	}
+
+	return &out
+}
+
+// Token returns the token
+func (obj *expectation) Token() grammars.Token {
+	return obj.token
+}
+
+// Line returns the line index
+func (obj *expectation) Line() uint {
+	return obj.line
+}
+
+// Element returns the expected element
+func (obj *expectation) Element() grammars.Element {
+	return obj.element
+}
+
+// Parents returns the enclosing tokens, from the root to the direct parent
+func (obj *expectation) Parents() []grammars.Token {
+	return obj.parents
+}
@@ -0,0 +1,295 @@
+package generators
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/steve-care-software/grammars/applications"
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+type application struct {
+	parser         applications.Application
+	grammarBuilder grammars.Builder
+	random         *rand.Rand
+	cap            uint
+	depth          uint
+}
+
+type generation struct {
+	minimums   *minimums
+	stack      []grammars.Token
+	isShortest bool
+}
+
+func createApplication(
+	parser applications.Application,
+	grammarBuilder grammars.Builder,
+	random *rand.Rand,
+	cap uint,
+	depth uint,
+) Application {
+	out := application{
+		parser:         parser,
+		grammarBuilder: grammarBuilder,
+		random:         random,
+		cap:            cap,
+		depth:          depth,
+	}
+
+	return &out
+}
+
+// Generate generates random data that the grammar entirely matches
+func (app *application) Generate(grammar grammars.Grammar) ([]byte, error) {
+	state := &generation{
+		minimums:   createMinimums(grammar),
+		stack:      []grammars.Token{},
+		isShortest: false,
+	}
+
+	// the lines are ordered alternatives, therefore the generated data is verified against the grammar:
+	for i := 0; i < maxAttempts; i++ {
+		output, err := app.grammar(state, grammar, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = app.parser.ExecuteComplete(grammar, output)
+		if err == nil {
+			return output, nil
+		}
+	}
+
+	str := fmt.Sprintf("the grammar (hash: %s) did not match any of the %d generated data", grammar.Hash().String(), maxAttempts)
+	return nil, errors.New(str)
+}
+
+// Shortest returns the shortest data that the token entirely matches
+func (app *application) Shortest(token grammars.Token) ([]byte, error) {
+	grammar, err := app.grammarBuilder.Create().WithRoot(token).Now()
+	if err != nil {
+		return nil, err
+	}
+
+	state := &generation{
+		minimums:   createMinimums(grammar),
+		stack:      []grammars.Token{},
+		isShortest: true,
+	}
+
+	output, err := app.token(state, token, nil, app.depth)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = app.parser.ExecuteComplete(grammar, output)
+	if err == nil {
+		return output, nil
+	}
+
+	// the lines are ordered alternatives, therefore the shortest data might be another one:
+	list, err := app.Enumerate(token, uint(len(output))+app.cap)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(list) <= 0 {
+		str := fmt.Sprintf("the token (name: %s) did not match any data of %d bytes or less", token.Name(), uint(len(output))+app.cap)
+		return nil, errors.New(str)
+	}
+
+	return list[0], nil
+}
+
+func (app *application) grammar(state *generation, grammar grammars.Grammar, depth uint) ([]byte, error) {
+	channels := []grammars.Channel{}
+	if grammar.HasChannels() {
+		for _, oneChannel := range grammar.Channels() {
+			// the conditional channels are never generated:
+			if oneChannel.HasCondition() {
+				continue
+			}
+
+			channels = append(channels, oneChannel)
+		}
+	}
+
+	return app.token(state, grammar.Root(), channels, depth)
+}
+
+func (app *application) token(state *generation, token grammars.Token, channels []grammars.Channel, depth uint) ([]byte, error) {
+	index, ok := state.minimums.line(token)
+	if !ok {
+		str := fmt.Sprintf("the token (name: %s) cannot generate data because none of its lines terminates", token.Name())
+		return nil, errors.New(str)
+	}
+
+	lines := token.Lines()
+	if depth < app.depth {
+		candidates := []int{}
+		for idx, oneLine := range lines {
+			if _, ok := state.minimums.lineLength(oneLine); ok {
+				candidates = append(candidates, idx)
+			}
+		}
+
+		index = candidates[app.random.Intn(len(candidates))]
+	}
+
+	state.stack = append(state.stack, token)
+	defer func() {
+		state.stack = state.stack[:len(state.stack)-1]
+	}()
+
+	output := []byte{}
+	for _, oneElement := range lines[index].Elements() {
+		amount := app.amount(oneElement.Cardinality(), depth)
+		for i := uint(0); i < amount; i++ {
+			data, err := app.content(state, oneElement.Content(), channels, depth)
+			if err != nil {
+				return nil, err
+			}
+
+			output = append(output, data...)
+		}
+	}
+
+	return output, nil
+}
+
+func (app *application) amount(cardinality grammars.Cardinality, depth uint) uint {
+	min := cardinality.Min()
+	if depth >= app.depth {
+		return min
+	}
+
+	max := min + app.cap
+	if cardinality.HasMax() {
+		max = *cardinality.Max()
+	}
+
+	return min + uint(app.random.Intn(int(max-min)+1))
+}
+
+func (app *application) content(state *generation, content grammars.ElementContent, channels []grammars.Channel, depth uint) ([]byte, error) {
+	if content.IsValue() {
+		prefix, err := app.channel(state, channels, depth)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(prefix, content.Value()...), nil
+	}
+
+	if content.IsGrammar() {
+		prefix, err := app.channel(state, channels, depth)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := app.grammar(state, content.Grammar(), depth+1)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(prefix, data...), nil
+	}
+
+	if content.IsRecursive() {
+		name := content.Recursive()
+		for i := len(state.stack) - 1; i >= 0; i-- {
+			if state.stack[i].Name() == name {
+				return app.token(state, state.stack[i], channels, depth+1)
+			}
+		}
+
+		str := fmt.Sprintf("the token (name: %s) was expected to be recursive, but it is not in the current stack", name)
+		return nil, errors.New(str)
+	}
+
+	instance := content.Instance()
+	if instance.IsToken() {
+		return app.token(state, instance.Token(), channels, depth+1)
+	}
+
+	return app.everything(state, instance.Everything(), depth+1)
+}
+
+func (app *application) channel(state *generation, channels []grammars.Channel, depth uint) ([]byte, error) {
+	if len(channels) <= 0 || depth >= app.depth || app.random.Intn(channelOdds) != 0 {
+		return []byte{}, nil
+	}
+
+	channel := channels[app.random.Intn(len(channels))]
+	return app.token(state, channel.Token(), nil, depth+1)
+}
+
+func (app *application) everything(state *generation, everything grammars.Everything, depth uint) ([]byte, error) {
+	exception, err := app.grammarBuilder.Create().WithRoot(everything.Exception()).Now()
+	if err != nil {
+		return nil, err
+	}
+
+	if state.isShortest {
+		for value := byte(' '); value <= '~'; value++ {
+			if !app.matches(exception, []byte{value}) {
+				return []byte{value}, nil
+			}
+		}
+	}
+
+	output := []byte{}
+	amount := 1 + app.random.Intn(int(app.cap)+1)
+	for len(output) < amount {
+		if everything.HasEscape() && depth < app.depth && app.random.Intn(escapeOdds) == 0 {
+			escape, err := app.token(state, everything.Escape(), nil, depth+1)
+			if err != nil {
+				return nil, err
+			}
+
+			escaped, err := app.token(state, everything.Exception(), nil, depth+1)
+			if err != nil {
+				return nil, err
+			}
+
+			output = append(output, escape...)
+			output = append(output, escaped...)
+			continue
+		}
+
+		// printable characters are generated, as long as the exception does not match any of their suffixes:
+		isAppended := false
+		for i := 0; i < maxAttempts; i++ {
+			data := append(append([]byte{}, output...), byte(' '+app.random.Intn('~'-' '+1)))
+			if !app.matches(exception, data) {
+				output = data
+				isAppended = true
+				break
+			}
+		}
+
+		if !isAppended {
+			break
+		}
+	}
+
+	if len(output) <= 0 {
+		str := fmt.Sprintf("the everything (hash: %s) could not generate data that does not match its exception", everything.Hash().String())
+		return nil, errors.New(str)
+	}
+
+	return output, nil
+}
+
+func (app *application) matches(exception grammars.Grammar, data []byte) bool {
+	for i := range data {
+		_, err := app.parser.Execute(exception, data[i:])
+		if err == nil {
+			return true
+		}
+	}
+
+	return false
+}
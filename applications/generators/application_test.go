@@ -0,0 +1,291 @@
+package generators_test
+
+import (
+	"bytes"
+	"testing"
+
+	ast_applications "github.com/steve-care-software/grammars/applications"
+	"github.com/steve-care-software/grammars/applications/generators"
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/infrastructure/scripts"
+)
+
+func TestGenerator_Success(t *testing.T) {
+	script := `
+		@list;
+		-space;
+
+		list: open item+ close;
+		item: string | number[1,3];
+		string: quote text quote;
+		text: #quote!backslash;
+		number: one | two;
+
+		open: 91;
+		close: 93;
+		quote: 34;
+		backslash: 92;
+		one: 49;
+		two: 50;
+		space: 32;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	grammarApp := ast_applications.NewApplication()
+	list := []grammars.Grammar{
+		reference.Root(),
+		scripts.NewGrammar().Grammar().Root(),
+	}
+
+	for idx, oneGrammar := range list {
+		first, err := generators.NewBuilder().Create().WithSeed(int64(idx)).WithCap(4).Now()
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		second, err := generators.NewBuilder().Create().WithSeed(int64(idx)).WithCap(4).Now()
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		for i := 0; i < 5; i++ {
+			data, err := first.Generate(oneGrammar)
+			if err != nil {
+				t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+				return
+			}
+
+			retData, err := second.Generate(oneGrammar)
+			if err != nil {
+				t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+				return
+			}
+
+			if !bytes.Equal(data, retData) {
+				t.Errorf("the generated data was expected to be the same using the same seed")
+				return
+			}
+
+			_, err = grammarApp.ExecuteComplete(oneGrammar, data)
+			if err != nil {
+				t.Errorf("the generated data (%s) was expected to match the grammar, error returned: %s", data, err.Error())
+				return
+			}
+		}
+	}
+}
+
+func TestGenerator_withLeftRecursion_Success(t *testing.T) {
+	script := `
+		@expression;
+		expression: expression plus term
+			| expression minus term
+			| term
+		;
+
+		term: one | open expression close;
+
+		one: 49;
+		plus: 43;
+		minus: 45;
+		open: 40;
+		close: 41;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	grammarApp := ast_applications.NewApplication()
+	generated := map[string]bool{}
+	for seed := int64(0); seed < 8; seed++ {
+		generatorApp, err := generators.NewBuilder().Create().WithSeed(seed).Now()
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		data, err := generatorApp.Generate(reference.Root())
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		_, err = grammarApp.ExecuteComplete(reference.Root(), data)
+		if err != nil {
+			t.Errorf("the generated data (%s) was expected to match the grammar, error returned: %s", data, err.Error())
+			return
+		}
+
+		generated[string(data)] = true
+	}
+
+	// the left recursive lines are expected to be expanded, therefore the seeds do not all generate the same data:
+	if len(generated) < 4 {
+		t.Errorf("the seeds were expected to generate at least %d different data, %d generated: %v", 4, len(generated), generated)
+		return
+	}
+}
+
+func TestGenerator_withoutSeed_returnsError(t *testing.T) {
+	_, err := generators.NewBuilder().Create().Now()
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestGenerator_enumerate_Success(t *testing.T) {
+	script := `
+		@sample;
+		sample: word | content;
+		word: lowerA | lowerA lowerB | number;
+		number: digit+;
+		digit: zero | one;
+		content: text;
+		text: #lowerB;
+
+		lowerA: 97;
+		lowerB: 98;
+		zero: 48;
+		one: 49;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	tokens := map[string]grammars.Token{}
+	for _, oneToken := range reference.Tokens().List() {
+		tokens[oneToken.Name()] = oneToken.Reference()
+	}
+
+	generatorApp, err := generators.NewBuilder().Create().WithSeed(0).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := map[string]string{
+		"word":    "0,1,a,00,01,10,11",
+		"number":  "0,1,00,01,10,11",
+		"content": " ,  ",
+	}
+
+	for oneName, oneExpected := range expected {
+		list, err := generatorApp.Enumerate(tokens[oneName], 2)
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if string(bytes.Join(list, []byte(","))) != oneExpected {
+			t.Errorf("the token (name: %s) was expected to enumerate %s, %s returned", oneName, oneExpected, bytes.Join(list, []byte(",")))
+			return
+		}
+	}
+
+	shortests := map[string]string{
+		"word":    "a",
+		"number":  "0",
+		"content": " ",
+	}
+
+	for oneName, oneExpected := range shortests {
+		shortest, err := generatorApp.Shortest(tokens[oneName])
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if string(shortest) != oneExpected {
+			t.Errorf("the token (name: %s) was expected to have %q as shortest data, %q returned", oneName, oneExpected, shortest)
+			return
+		}
+	}
+}
+
+func TestGenerator_enumerate_withEmptyData_Success(t *testing.T) {
+	script := `
+		@optional;
+		optional: lowerA?;
+		lowerA: 97;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	generatorApp, err := generators.NewBuilder().Create().WithSeed(0).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	list, err := generatorApp.Enumerate(reference.Root().Root(), 4)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(list) != 2 || len(list[0]) != 0 || string(list[1]) != "a" {
+		t.Errorf("the token was expected to enumerate the empty data then %q, %q returned", "a", list)
+		return
+	}
+
+	shortest, err := generatorApp.Shortest(reference.Root().Root())
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(shortest) != 0 {
+		t.Errorf("the token was expected to have the empty data as shortest data, %q returned", shortest)
+		return
+	}
+}
+
+func TestGenerator_enumerate_withEverything_usesRepresentativeByte(t *testing.T) {
+	script := `
+		@text;
+		text: #lowerB;
+		lowerB: 98;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	generatorApp, err := generators.NewBuilder().Create().WithSeed(0).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// every byte other than the exception is represented by a single printable byte:
+	list, err := generatorApp.Enumerate(reference.Root().Root(), 1)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(list) != 1 || string(list[0]) != " " {
+		t.Errorf("the token was expected to only enumerate %q, %q returned", " ", list)
+		return
+	}
+}
@@ -0,0 +1,74 @@
+package generators
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/steve-care-software/grammars/applications"
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+type builder struct {
+	parser         applications.Application
+	grammarBuilder grammars.Builder
+	pSeed          *int64
+	cap            uint
+	depth          uint
+}
+
+func createBuilder(
+	parser applications.Application,
+	grammarBuilder grammars.Builder,
+) Builder {
+	out := builder{
+		parser:         parser,
+		grammarBuilder: grammarBuilder,
+		pSeed:          nil,
+		cap:            defaultCap,
+		depth:          defaultDepth,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *builder) Create() Builder {
+	return createBuilder(
+		app.parser,
+		app.grammarBuilder,
+	)
+}
+
+// WithSeed adds a seed to the builder
+func (app *builder) WithSeed(seed int64) Builder {
+	app.pSeed = &seed
+	return app
+}
+
+// WithCap adds the maximum amount of repetitions above the minimum of unbounded cardinalities to the builder
+func (app *builder) WithCap(cap uint) Builder {
+	app.cap = cap
+	return app
+}
+
+// WithDepth adds the depth after which the shortest lines are generated to the builder
+func (app *builder) WithDepth(depth uint) Builder {
+	app.depth = depth
+	return app
+}
+
+// Now builds a new Application instance
+func (app *builder) Now() (Application, error) {
+	if app.pSeed == nil {
+		return nil, errors.New("the seed is mandatory in order to build an Application instance")
+	}
+
+	random := rand.New(rand.NewSource(*app.pSeed))
+	return createApplication(
+		app.parser,
+		app.grammarBuilder,
+		random,
+		app.cap,
+		app.depth,
+	), nil
+}
@@ -0,0 +1,274 @@
+package generators
+
+import (
+	"sort"
+
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+type enumeration struct {
+	minimums    *minimums
+	alphabet    []byte
+	length      int
+	sets        map[string]map[string]bool
+	everythings map[string]map[string]bool
+}
+
+// Enumerate returns the data of length bytes or less that the token entirely matches, including the empty data, ordered by length then by bytes, the everything instances are not enumerated on every byte, they only use the bytes of the values of the token plus a single printable byte that represents all the other bytes
+func (app *application) Enumerate(token grammars.Token, length uint) ([][]byte, error) {
+	grammar, err := app.grammarBuilder.Create().WithRoot(token).Now()
+	if err != nil {
+		return nil, err
+	}
+
+	minimums := createMinimums(grammar)
+	state := &enumeration{
+		minimums:    minimums,
+		alphabet:    app.alphabet(minimums),
+		length:      int(length),
+		sets:        map[string]map[string]bool{},
+		everythings: map[string]map[string]bool{},
+	}
+
+	// the sets only grow, therefore they are computed until they no longer change:
+	isChanged := true
+	for isChanged {
+		isChanged = false
+		for _, oneToken := range minimums.tokens {
+			set := map[string]bool{}
+			for _, oneLine := range oneToken.Lines() {
+				for oneData := range app.lineSet(state, oneLine) {
+					set[oneData] = true
+				}
+			}
+
+			keyname := string(oneToken.Hash())
+			if len(set) > len(state.sets[keyname]) {
+				state.sets[keyname] = set
+				isChanged = true
+			}
+		}
+	}
+
+	keys := []string{}
+	for oneData := range state.sets[string(token.Hash())] {
+		keys = append(keys, oneData)
+	}
+
+	sort.Slice(keys, func(i int, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) < len(keys[j])
+		}
+
+		return keys[i] < keys[j]
+	})
+
+	// the lines are ordered alternatives, therefore only the data the token entirely matches is kept:
+	output := [][]byte{}
+	for _, oneKey := range keys {
+		data := []byte(oneKey)
+		_, err := app.parser.ExecuteComplete(grammar, data)
+		if err != nil {
+			continue
+		}
+
+		output = append(output, data)
+	}
+
+	return output, nil
+}
+
+func (app *application) alphabet(minimums *minimums) []byte {
+	found := map[byte]bool{}
+	for _, oneToken := range minimums.tokens {
+		for _, oneLine := range oneToken.Lines() {
+			for _, oneElement := range oneLine.Elements() {
+				content := oneElement.Content()
+				if !content.IsValue() {
+					continue
+				}
+
+				for _, oneByte := range content.Value() {
+					found[oneByte] = true
+				}
+			}
+		}
+	}
+
+	output := []byte{}
+	for oneByte := range found {
+		output = append(output, oneByte)
+	}
+
+	for value := byte(' '); value <= '~'; value++ {
+		if _, ok := found[value]; !ok {
+			output = append(output, value)
+			break
+		}
+	}
+
+	sort.Slice(output, func(i int, j int) bool {
+		return output[i] < output[j]
+	})
+
+	return output
+}
+
+func (app *application) lineSet(state *enumeration, line grammars.Line) map[string]bool {
+	output := map[string]bool{
+		"": true,
+	}
+
+	for _, oneElement := range line.Elements() {
+		set := app.elementSet(state, oneElement)
+		output = app.concat(state, output, set)
+		if len(output) <= 0 {
+			break
+		}
+	}
+
+	return output
+}
+
+func (app *application) elementSet(state *enumeration, element grammars.Element) map[string]bool {
+	cardinality := element.Cardinality()
+	min := int(cardinality.Min())
+	max := min + state.length
+	if cardinality.HasMax() {
+		max = int(*cardinality.Max())
+	}
+
+	content := app.contentSet(state, element.Content())
+	output := map[string]bool{}
+	repetitions := map[string]bool{
+		"": true,
+	}
+
+	for amount := 0; amount <= max; amount++ {
+		if amount > 0 {
+			repetitions = app.concat(state, repetitions, content)
+		}
+
+		if len(repetitions) <= 0 {
+			break
+		}
+
+		if amount < min {
+			continue
+		}
+
+		for oneData := range repetitions {
+			output[oneData] = true
+		}
+	}
+
+	return output
+}
+
+func (app *application) contentSet(state *enumeration, content grammars.ElementContent) map[string]bool {
+	if content.IsValue() {
+		value := content.Value()
+		if len(value) > state.length {
+			return map[string]bool{}
+		}
+
+		return map[string]bool{
+			string(value): true,
+		}
+	}
+
+	if content.IsGrammar() {
+		return state.sets[string(content.Grammar().Root().Hash())]
+	}
+
+	if content.IsRecursive() {
+		if token, ok := state.minimums.names[content.Recursive()]; ok {
+			return state.sets[string(token.Hash())]
+		}
+
+		return map[string]bool{}
+	}
+
+	instance := content.Instance()
+	if instance.IsToken() {
+		return state.sets[string(instance.Token().Hash())]
+	}
+
+	return app.everythingSet(state, instance.Everything())
+}
+
+func (app *application) everythingSet(state *enumeration, everything grammars.Everything) map[string]bool {
+	keyname := string(everything.Hash())
+	if _, ok := state.everythings[keyname]; !ok {
+		state.everythings[keyname] = map[string]bool{}
+		exception, err := app.grammarBuilder.Create().WithRoot(everything.Exception()).Now()
+		if err == nil {
+			// the data is extended one byte at a time, as long as the exception does not match any of its suffixes:
+			frontier := []string{""}
+			for len(frontier) > 0 {
+				next := []string{}
+				for _, oneData := range frontier {
+					if len(oneData) >= state.length {
+						continue
+					}
+
+					for _, oneByte := range state.alphabet {
+						data := oneData + string([]byte{oneByte})
+						if app.matches(exception, []byte(data)) {
+							continue
+						}
+
+						state.everythings[keyname][data] = true
+						next = append(next, data)
+					}
+				}
+
+				frontier = next
+			}
+		}
+	}
+
+	output := state.everythings[keyname]
+	if !everything.HasEscape() {
+		return output
+	}
+
+	// the escaped exceptions can be placed anywhere in the data:
+	escapes := state.sets[string(everything.Escape().Hash())]
+	exceptions := state.sets[string(everything.Exception().Hash())]
+	units := app.concat(state, escapes, exceptions)
+	for oneData := range output {
+		units[oneData] = true
+	}
+
+	delete(units, "")
+	output = units
+	for {
+		grown := app.concat(state, output, units)
+		amount := len(output)
+		for oneData := range grown {
+			output[oneData] = true
+		}
+
+		if len(output) == amount {
+			break
+		}
+	}
+
+	return output
+}
+
+func (app *application) concat(state *enumeration, first map[string]bool, second map[string]bool) map[string]bool {
+	output := map[string]bool{}
+	for oneFirst := range first {
+		for oneSecond := range second {
+			if len(oneFirst)+len(oneSecond) > state.length {
+				continue
+			}
+
+			output[oneFirst+oneSecond] = true
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,158 @@
+package generators
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+type minimums struct {
+	tokens  []grammars.Token
+	names   map[string]grammars.Token
+	lengths map[string]uint
+	lines   map[string]int
+}
+
+func createMinimums(grammar grammars.Grammar) *minimums {
+	out := minimums{
+		tokens:  []grammars.Token{},
+		names:   map[string]grammars.Token{},
+		lengths: map[string]uint{},
+		lines:   map[string]int{},
+	}
+
+	out.grammar(grammar, map[string]bool{})
+	out.resolve()
+	return &out
+}
+
+// length returns the minimum length of the data generated by the token, if it can be generated
+func (obj *minimums) length(token grammars.Token) (uint, bool) {
+	length, ok := obj.lengths[string(token.Hash())]
+	return length, ok
+}
+
+// line returns the index of the line generating the shortest data of the token, if it can be generated
+func (obj *minimums) line(token grammars.Token) (int, bool) {
+	index, ok := obj.lines[string(token.Hash())]
+	return index, ok
+}
+
+// lineLength returns the minimum length of the data generated by the line, if it can be generated
+func (obj *minimums) lineLength(line grammars.Line) (uint, bool) {
+	total := uint(0)
+	for _, oneElement := range line.Elements() {
+		min := oneElement.Cardinality().Min()
+		if min <= 0 {
+			continue
+		}
+
+		length, ok := obj.contentLength(oneElement.Content())
+		if !ok {
+			return 0, false
+		}
+
+		total += min * length
+	}
+
+	return total, true
+}
+
+func (obj *minimums) contentLength(content grammars.ElementContent) (uint, bool) {
+	if content.IsValue() {
+		return uint(len(content.Value())), true
+	}
+
+	if content.IsGrammar() {
+		return obj.length(content.Grammar().Root())
+	}
+
+	if content.IsRecursive() {
+		if token, ok := obj.names[content.Recursive()]; ok {
+			return obj.length(token)
+		}
+
+		return 0, false
+	}
+
+	instance := content.Instance()
+	if instance.IsToken() {
+		return obj.length(instance.Token())
+	}
+
+	// everything is generated using at least one byte:
+	return 1, true
+}
+
+// resolve computes the minimums until they no longer decrease, a minimum only depends on the minimums resolved before it, therefore the shortest lines never loop
+func (obj *minimums) resolve() {
+	isChanged := true
+	for isChanged {
+		isChanged = false
+		for _, oneToken := range obj.tokens {
+			keyname := string(oneToken.Hash())
+			for idx, oneLine := range oneToken.Lines() {
+				length, ok := obj.lineLength(oneLine)
+				if !ok {
+					continue
+				}
+
+				if current, ok := obj.lengths[keyname]; ok && current <= length {
+					continue
+				}
+
+				obj.lengths[keyname] = length
+				obj.lines[keyname] = idx
+				isChanged = true
+			}
+		}
+	}
+}
+
+func (obj *minimums) grammar(grammar grammars.Grammar, visited map[string]bool) {
+	obj.token(grammar.Root(), visited)
+	if grammar.HasChannels() {
+		for _, oneChannel := range grammar.Channels() {
+			obj.token(oneChannel.Token(), visited)
+		}
+	}
+}
+
+func (obj *minimums) token(token grammars.Token, visited map[string]bool) {
+	keyname := string(token.Hash())
+	if _, ok := visited[keyname]; ok {
+		return
+	}
+
+	visited[keyname] = true
+	obj.tokens = append(obj.tokens, token)
+	if token.HasName() {
+		if _, ok := obj.names[token.Name()]; !ok {
+			obj.names[token.Name()] = token
+		}
+	}
+
+	for _, oneLine := range token.Lines() {
+		for _, oneElement := range oneLine.Elements() {
+			content := oneElement.Content()
+			if content.IsGrammar() {
+				obj.grammar(content.Grammar(), visited)
+				continue
+			}
+
+			if !content.IsInstance() {
+				continue
+			}
+
+			instance := content.Instance()
+			if instance.IsToken() {
+				obj.token(instance.Token(), visited)
+				continue
+			}
+
+			everything := instance.Everything()
+			obj.token(everything.Exception(), visited)
+			if everything.HasEscape() {
+				obj.token(everything.Escape(), visited)
+			}
+		}
+	}
+}
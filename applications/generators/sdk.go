@@ -0,0 +1,35 @@
+package generators
+
+import (
+	"github.com/steve-care-software/grammars/applications"
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+const defaultCap = 3
+const defaultDepth = 8
+const maxAttempts = 100
+const channelOdds = 4
+const escapeOdds = 4
+
+// NewBuilder creates a new generator application builder
+func NewBuilder() Builder {
+	parser := applications.NewApplication()
+	grammarBuilder := grammars.NewBuilder()
+	return createBuilder(parser, grammarBuilder)
+}
+
+// Builder represents a generator application builder
+type Builder interface {
+	Create() Builder
+	WithSeed(seed int64) Builder
+	WithCap(cap uint) Builder
+	WithDepth(depth uint) Builder
+	Now() (Application, error)
+}
+
+// Application represents a generator application
+type Application interface {
+	Generate(grammar grammars.Grammar) ([]byte, error)
+	Shortest(token grammars.Token) ([]byte, error)
+	Enumerate(token grammars.Token, length uint) ([][]byte, error)
+}
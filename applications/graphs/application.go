@@ -0,0 +1,228 @@
+package graphs
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/references"
+)
+
+type application struct {
+}
+
+type graphing struct {
+	reference  references.Reference
+	nodes      []Node
+	indexes    map[string]int
+	edges      []Edge
+	adjacency  map[int][]int
+	recursives []recursive
+	names      map[string]int
+}
+
+type recursive struct {
+	from int
+	name string
+}
+
+type tarjan struct {
+	index   int
+	indexes map[int]int
+	lows    map[int]int
+	stack   []int
+	onStack map[int]bool
+	cycles  [][]Node
+}
+
+func createApplication() Application {
+	out := application{}
+	return &out
+}
+
+// Graph builds the dependency graph of the tokens of the reference
+func (app *application) Graph(reference references.Reference) Graph {
+	state := &graphing{
+		reference:  reference,
+		nodes:      []Node{},
+		indexes:    map[string]int{},
+		edges:      []Edge{},
+		adjacency:  map[int][]int{},
+		recursives: []recursive{},
+		names:      map[string]int{},
+	}
+
+	grammar := reference.Root()
+	root := app.grammar(state, grammar)
+	reachable := len(state.nodes)
+	for _, oneToken := range reference.Tokens().List() {
+		app.token(state, oneToken.Reference())
+	}
+
+	for _, oneRecursive := range state.recursives {
+		if to, ok := state.names[oneRecursive.name]; ok {
+			app.edge(state, KindRecursive, oneRecursive.from, to)
+		}
+	}
+
+	unreachable := []Node{}
+	unreachable = append(unreachable, state.nodes[reachable:]...)
+	return createGraph(state.nodes[root], state.nodes, state.edges, app.cycles(state), unreachable)
+}
+
+func (app *application) grammar(state *graphing, grammar grammars.Grammar) int {
+	root := app.token(state, grammar.Root())
+	if !grammar.HasChannels() {
+		return root
+	}
+
+	for _, oneChannel := range grammar.Channels() {
+		channel := app.token(state, oneChannel.Token())
+		app.edge(state, KindChannel, root, channel)
+		if !oneChannel.HasCondition() {
+			continue
+		}
+
+		condition := oneChannel.Condition()
+		if condition.HasPrevious() {
+			app.edge(state, KindCondition, channel, app.token(state, condition.Previous()))
+		}
+
+		if condition.HasNext() {
+			app.edge(state, KindCondition, channel, app.token(state, condition.Next()))
+		}
+	}
+
+	return root
+}
+
+func (app *application) token(state *graphing, token grammars.Token) int {
+	keyname := string(token.Hash())
+	if index, ok := state.indexes[keyname]; ok {
+		return index
+	}
+
+	index := len(state.nodes)
+	name := state.reference.Tokens().Name(token)
+	state.nodes = append(state.nodes, createNode(name, token))
+	state.indexes[keyname] = index
+	state.names[name] = index
+	if token.HasName() {
+		state.names[token.Name()] = index
+	}
+
+	for _, oneLine := range token.Lines() {
+		for _, oneElement := range oneLine.Elements() {
+			content := oneElement.Content()
+			if content.IsGrammar() {
+				app.edge(state, KindExternal, index, app.grammar(state, content.Grammar()))
+				continue
+			}
+
+			if content.IsRecursive() {
+				state.recursives = append(state.recursives, recursive{
+					from: index,
+					name: content.Recursive(),
+				})
+
+				continue
+			}
+
+			if !content.IsInstance() {
+				continue
+			}
+
+			instance := content.Instance()
+			if instance.IsToken() {
+				app.edge(state, KindInstance, index, app.token(state, instance.Token()))
+				continue
+			}
+
+			everything := instance.Everything()
+			app.edge(state, KindException, index, app.token(state, everything.Exception()))
+			if everything.HasEscape() {
+				app.edge(state, KindEscape, index, app.token(state, everything.Escape()))
+			}
+		}
+	}
+
+	return index
+}
+
+// edge adds an edge between two nodes, unless they are already linked using the same kind
+func (app *application) edge(state *graphing, kind uint8, from int, to int) {
+	for _, oneEdge := range state.edges {
+		if oneEdge.Kind() == kind && oneEdge.From() == state.nodes[from] && oneEdge.To() == state.nodes[to] {
+			return
+		}
+	}
+
+	state.edges = append(state.edges, createEdge(kind, state.nodes[from], state.nodes[to]))
+	state.adjacency[from] = append(state.adjacency[from], to)
+}
+
+// cycles returns the strongly connected components that contain more than one node or a node that references itself
+func (app *application) cycles(state *graphing) [][]Node {
+	ins := &tarjan{
+		index:   0,
+		indexes: map[int]int{},
+		lows:    map[int]int{},
+		stack:   []int{},
+		onStack: map[int]bool{},
+		cycles:  [][]Node{},
+	}
+
+	for index := range state.nodes {
+		if _, ok := ins.indexes[index]; ok {
+			continue
+		}
+
+		app.connect(state, ins, index)
+	}
+
+	return ins.cycles
+}
+
+func (app *application) connect(state *graphing, ins *tarjan, index int) {
+	ins.indexes[index] = ins.index
+	ins.lows[index] = ins.index
+	ins.index++
+	ins.stack = append(ins.stack, index)
+	ins.onStack[index] = true
+
+	isSelfReferenced := false
+	for _, oneNext := range state.adjacency[index] {
+		if oneNext == index {
+			isSelfReferenced = true
+		}
+
+		if _, ok := ins.indexes[oneNext]; !ok {
+			app.connect(state, ins, oneNext)
+			if ins.lows[oneNext] < ins.lows[index] {
+				ins.lows[index] = ins.lows[oneNext]
+			}
+
+			continue
+		}
+
+		if ins.onStack[oneNext] && ins.indexes[oneNext] < ins.lows[index] {
+			ins.lows[index] = ins.indexes[oneNext]
+		}
+	}
+
+	if ins.lows[index] != ins.indexes[index] {
+		return
+	}
+
+	component := []Node{}
+	for {
+		last := ins.stack[len(ins.stack)-1]
+		ins.stack = ins.stack[:len(ins.stack)-1]
+		ins.onStack[last] = false
+		component = append([]Node{state.nodes[last]}, component...)
+		if last == index {
+			break
+		}
+	}
+
+	if len(component) > 1 || isSelfReferenced {
+		ins.cycles = append(ins.cycles, component)
+	}
+}
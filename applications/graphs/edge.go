@@ -0,0 +1,36 @@
+package graphs
+
+type edge struct {
+	kind uint8
+	from Node
+	to   Node
+}
+
+func createEdge(
+	kind uint8,
+	from Node,
+	to Node,
+) Edge {
+	out := edge{
+		kind: kind,
+		from: from,
+		to:   to,
+	}
+
+	return &out
+}
+
+// Kind returns the kind of reference
+func (obj *edge) Kind() uint8 {
+	return obj.kind
+}
+
+// From returns the referencing node
+func (obj *edge) From() Node {
+	return obj.from
+}
+
+// To returns the referenced node
+func (obj *edge) To() Node {
+	return obj.to
+}
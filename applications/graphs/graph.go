@@ -0,0 +1,52 @@
+package graphs
+
+type graph struct {
+	root        Node
+	nodes       []Node
+	edges       []Edge
+	cycles      [][]Node
+	unreachable []Node
+}
+
+func createGraph(
+	root Node,
+	nodes []Node,
+	edges []Edge,
+	cycles [][]Node,
+	unreachable []Node,
+) Graph {
+	out := graph{
+		root:        root,
+		nodes:       nodes,
+		edges:       edges,
+		cycles:      cycles,
+		unreachable: unreachable,
+	}
+
+	return &out
+}
+
+// Root returns the root node
+func (obj *graph) Root() Node {
+	return obj.root
+}
+
+// Nodes returns the nodes
+func (obj *graph) Nodes() []Node {
+	return obj.nodes
+}
+
+// Edges returns the edges
+func (obj *graph) Edges() []Edge {
+	return obj.edges
+}
+
+// Cycles returns the groups of nodes that reference each other, directly or indirectly
+func (obj *graph) Cycles() [][]Node {
+	return obj.cycles
+}
+
+// Unreachable returns the nodes of the reference that cannot be reached from the root
+func (obj *graph) Unreachable() []Node {
+	return obj.unreachable
+}
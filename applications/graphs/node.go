@@ -0,0 +1,32 @@
+package graphs
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+type node struct {
+	name  string
+	token grammars.Token
+}
+
+func createNode(
+	name string,
+	token grammars.Token,
+) Node {
+	out := node{
+		name:  name,
+		token: token,
+	}
+
+	return &out
+}
+
+// Name returns the name
+func (obj *node) Name() string {
+	return obj.name
+}
+
+// Token returns the token
+func (obj *node) Token() grammars.Token {
+	return obj.token
+}
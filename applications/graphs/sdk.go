@@ -0,0 +1,59 @@
+package graphs
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/references"
+)
+
+// KindInstance represents an element that references a token
+const KindInstance uint8 = 0
+
+// KindException represents an everything instance that references its exception
+const KindException uint8 = 1
+
+// KindEscape represents an everything instance that references its escape
+const KindEscape uint8 = 2
+
+// KindRecursive represents an element that references an enclosing token by its name
+const KindRecursive uint8 = 3
+
+// KindExternal represents an element that references the root token of an external grammar
+const KindExternal uint8 = 4
+
+// KindChannel represents a grammar root that references the token of one of its channels
+const KindChannel uint8 = 5
+
+// KindCondition represents a channel that references the previous or next token of its condition
+const KindCondition uint8 = 6
+
+// NewApplication creates a new graph application
+func NewApplication() Application {
+	return createApplication()
+}
+
+// Application represents a graph application
+type Application interface {
+	Graph(reference references.Reference) Graph
+}
+
+// Graph represents the dependency graph of the tokens of a grammar
+type Graph interface {
+	Root() Node
+	Nodes() []Node
+	Edges() []Edge
+	Cycles() [][]Node
+	Unreachable() []Node
+}
+
+// Node represents a token of the graph
+type Node interface {
+	Name() string
+	Token() grammars.Token
+}
+
+// Edge represents a reference from a token to another
+type Edge interface {
+	Kind() uint8
+	From() Node
+	To() Node
+}
@@ -0,0 +1,43 @@
+package applications
+
+import "fmt"
+
+type limitError struct {
+	kind  uint8
+	limit uint
+}
+
+func createLimitError(
+	kind uint8,
+	limit uint,
+) LimitError {
+	out := limitError{
+		kind:  kind,
+		limit: limit,
+	}
+
+	return &out
+}
+
+// Error returns the error message
+func (obj *limitError) Error() string {
+	name := "steps"
+	switch obj.kind {
+	case LimitDepth:
+		name = "depth"
+	case LimitNodes:
+		name = "nodes"
+	}
+
+	return fmt.Sprintf("the %s limit (%d) was exceeded while parsing the input", name, obj.limit)
+}
+
+// Kind returns the kind of limit that was exceeded
+func (obj *limitError) Kind() uint8 {
+	return obj.kind
+}
+
+// Limit returns the value of the exceeded limit
+func (obj *limitError) Limit() uint {
+	return obj.limit
+}
@@ -0,0 +1,51 @@
+package applications
+
+type limits struct {
+	pSteps *uint
+	pDepth *uint
+	pNodes *uint
+}
+
+func createLimits(
+	pSteps *uint,
+	pDepth *uint,
+	pNodes *uint,
+) Limits {
+	out := limits{
+		pSteps: pSteps,
+		pDepth: pDepth,
+		pNodes: pNodes,
+	}
+
+	return &out
+}
+
+// HasSteps returns true if there is a steps limit, false otherwise
+func (obj *limits) HasSteps() bool {
+	return obj.pSteps != nil
+}
+
+// Steps returns the maximum amount of token parsing steps, if any
+func (obj *limits) Steps() *uint {
+	return obj.pSteps
+}
+
+// HasDepth returns true if there is a depth limit, false otherwise
+func (obj *limits) HasDepth() bool {
+	return obj.pDepth != nil
+}
+
+// Depth returns the maximum depth of nested tokens, if any
+func (obj *limits) Depth() *uint {
+	return obj.pDepth
+}
+
+// HasNodes returns true if there is a nodes limit, false otherwise
+func (obj *limits) HasNodes() bool {
+	return obj.pNodes != nil
+}
+
+// Nodes returns the maximum amount of tree nodes, if any
+func (obj *limits) Nodes() *uint {
+	return obj.pNodes
+}
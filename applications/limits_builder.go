@@ -0,0 +1,63 @@
+package applications
+
+import "errors"
+
+type limitsBuilder struct {
+	pSteps *uint
+	pDepth *uint
+	pNodes *uint
+}
+
+func createLimitsBuilder() LimitsBuilder {
+	out := limitsBuilder{
+		pSteps: nil,
+		pDepth: nil,
+		pNodes: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *limitsBuilder) Create() LimitsBuilder {
+	return createLimitsBuilder()
+}
+
+// WithSteps adds a steps limit to the builder
+func (app *limitsBuilder) WithSteps(steps uint) LimitsBuilder {
+	app.pSteps = &steps
+	return app
+}
+
+// WithDepth adds a depth limit to the builder
+func (app *limitsBuilder) WithDepth(depth uint) LimitsBuilder {
+	app.pDepth = &depth
+	return app
+}
+
+// WithNodes adds a nodes limit to the builder
+func (app *limitsBuilder) WithNodes(nodes uint) LimitsBuilder {
+	app.pNodes = &nodes
+	return app
+}
+
+// Now builds a new Limits instance
+func (app *limitsBuilder) Now() (Limits, error) {
+	if app.pSteps == nil && app.pDepth == nil && app.pNodes == nil {
+		return nil, errors.New("there must be at least a steps, depth or nodes limit in order to build a Limits instance")
+	}
+
+	if app.pSteps != nil && *app.pSteps <= 0 {
+		return nil, errors.New("the steps limit must be greater than zero")
+	}
+
+	if app.pDepth != nil && *app.pDepth <= 0 {
+		return nil, errors.New("the depth limit must be greater than zero")
+	}
+
+	if app.pNodes != nil && *app.pNodes <= 0 {
+		return nil, errors.New("the nodes limit must be greater than zero")
+	}
+
+	return createLimits(app.pSteps, app.pDepth, app.pNodes), nil
+}
@@ -0,0 +1,230 @@
+package mutators
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/grammars/applications"
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/trees"
+)
+
+type application struct {
+	parser         applications.Application
+	grammarBuilder grammars.Builder
+	suiteBuilder   grammars.SuiteBuilder
+}
+
+type candidate struct {
+	kind    uint8
+	content []byte
+}
+
+func createApplication(
+	parser applications.Application,
+	grammarBuilder grammars.Builder,
+	suiteBuilder grammars.SuiteBuilder,
+) Application {
+	out := application{
+		parser:         parser,
+		grammarBuilder: grammarBuilder,
+		suiteBuilder:   suiteBuilder,
+	}
+
+	return &out
+}
+
+// Mutate mutates a content that the token entirely matches using the channels of the grammar, then classifies the mutations using the token
+func (app *application) Mutate(grammar grammars.Grammar, token grammars.Token, content []byte) ([]Mutation, error) {
+	builder := app.grammarBuilder.Create().WithRoot(token)
+	if grammar.HasChannels() {
+		builder.WithChannels(grammar.Channels())
+	}
+
+	tokenGrammar, err := builder.Now()
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := app.parser.ExecuteComplete(tokenGrammar, content)
+	if err != nil {
+		str := fmt.Sprintf("the content was expected to be entirely matched by the token (name: %s): %s", token.Name(), err.Error())
+		return nil, errors.New(str)
+	}
+
+	// the mutations based on the tree come first, since they are more specific than the byte mutations:
+	cursor := uint(0)
+	candidates := app.tree(content, tree, &cursor)
+	for idx := range content {
+		candidates = append(candidates, candidate{
+			kind:    KindDeletion,
+			content: app.replace(content, uint(idx), uint(idx+1), []byte{}),
+		})
+	}
+
+	for idx := 0; idx < len(content)-1; idx++ {
+		candidates = append(candidates, candidate{
+			kind:    KindSwap,
+			content: app.replace(content, uint(idx), uint(idx+2), []byte{content[idx+1], content[idx]}),
+		})
+	}
+
+	// the mutations are unique, and the ones that are identical to the content are skipped:
+	output := []Mutation{}
+	found := map[string]bool{
+		string(content): true,
+	}
+
+	for _, oneCandidate := range candidates {
+		keyname := string(oneCandidate.content)
+		if _, ok := found[keyname]; ok || len(oneCandidate.content) <= 0 {
+			continue
+		}
+
+		found[keyname] = true
+		_, err := app.parser.ExecuteComplete(tokenGrammar, oneCandidate.content)
+		output = append(output, createMutation(oneCandidate.kind, oneCandidate.content, err == nil))
+	}
+
+	return output, nil
+}
+
+// Propose returns invalid suites built from the mutations of the valid suites of the token
+func (app *application) Propose(grammar grammars.Grammar, token grammars.Token) ([]grammars.Suite, error) {
+	output := []grammars.Suite{}
+	if !token.HasSuites() {
+		return output, nil
+	}
+
+	for _, oneSuite := range token.Suites() {
+		if !oneSuite.IsValid() {
+			continue
+		}
+
+		mutations, err := app.Mutate(grammar, token, oneSuite.Content())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, oneMutation := range mutations {
+			if oneMutation.IsValid() {
+				continue
+			}
+
+			suite, err := app.suiteBuilder.Create().WithInvalid(oneMutation.Content()).Now()
+			if err != nil {
+				return nil, err
+			}
+
+			output = append(output, suite)
+		}
+	}
+
+	return output, nil
+}
+
+// tree returns the candidates of the elements of the tree, the cursor is the offset where the previous element ends
+func (app *application) tree(content []byte, tree trees.Tree, pCursor *uint) []candidate {
+	output := []candidate{}
+	token := tree.Token()
+	if !token.HasSuccessful() {
+		return output
+	}
+
+	for _, oneElement := range token.Successful().Elements() {
+		output = append(output, app.element(content, oneElement, *pCursor)...)
+		for _, oneContent := range oneElement.Contents() {
+			if !oneContent.IsTree() {
+				continue
+			}
+
+			output = append(output, app.tree(content, oneContent.Tree(), pCursor)...)
+		}
+
+		if oneElement.HasPosition() {
+			*pCursor = oneElement.Position().End().Offset()
+		}
+	}
+
+	return output
+}
+
+func (app *application) element(content []byte, element trees.Element, cursor uint) []candidate {
+	output := []candidate{}
+	if !element.HasGrammar() || !element.HasPosition() {
+		return output
+	}
+
+	contents := element.Contents()
+	last := contents[len(contents)-1]
+	if !last.HasPosition() {
+		return output
+	}
+
+	// the last content is removed at the minimum, and repeated at the maximum:
+	start := last.Position().Start().Offset()
+	end := last.Position().End().Offset()
+	cardinality := element.Grammar().Cardinality()
+	amount := element.Amount()
+	if amount <= cardinality.Min() && amount > 0 {
+		output = append(output, candidate{
+			kind:    KindCardinality,
+			content: app.replace(content, start, end, []byte{}),
+		})
+	}
+
+	if cardinality.HasMax() && amount >= *cardinality.Max() {
+		output = append(output, candidate{
+			kind:    KindCardinality,
+			content: app.replace(content, end, end, content[start:end]),
+		})
+	}
+
+	elementContent := element.Grammar().Content()
+	if !elementContent.IsInstance() || !elementContent.Instance().IsEverything() {
+		return output
+	}
+
+	everything := elementContent.Instance().Everything()
+	if !everything.HasEscape() {
+		return output
+	}
+
+	escape, err := app.grammarBuilder.Create().WithRoot(everything.Escape()).Now()
+	if err != nil {
+		return output
+	}
+
+	exception, err := app.grammarBuilder.Create().WithRoot(everything.Exception()).Now()
+	if err != nil {
+		return output
+	}
+
+	// the escapes followed by an exception are removed, the escapes are not part of the position therefore the data is scanned from the cursor:
+	for idx := cursor; idx < element.Position().End().Offset(); idx++ {
+		escapeTree, err := app.parser.Execute(escape, content[idx:])
+		if err != nil || !escapeTree.HasRemaining() {
+			continue
+		}
+
+		remaining := escapeTree.Remaining()
+		_, err = app.parser.Execute(exception, remaining)
+		if err != nil {
+			continue
+		}
+
+		amount := uint(len(content[idx:]) - len(remaining))
+		output = append(output, candidate{
+			kind:    KindEscape,
+			content: app.replace(content, idx, idx+amount, []byte{}),
+		})
+	}
+
+	return output
+}
+
+func (app *application) replace(content []byte, start uint, end uint, value []byte) []byte {
+	output := append([]byte{}, content[:start]...)
+	output = append(output, value...)
+	return append(output, content[end:]...)
+}
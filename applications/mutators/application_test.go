@@ -0,0 +1,130 @@
+package mutators_test
+
+import (
+	"testing"
+
+	"github.com/steve-care-software/grammars/applications/mutators"
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/infrastructure/scripts"
+)
+
+func TestMutator_Success(t *testing.T) {
+	script := `
+		@item;
+		item: string | number;
+		string: quote text quote
+			---
+			valid: escapedQuote;
+		;
+
+		number: digit[1,2]
+			---
+			valid: oneTwo;
+		;
+
+		text: #quote!backslash;
+		digit: one | two;
+		escapedQuote: quote backslash quote lowerA quote;
+		oneTwo: one two;
+
+		quote: 34;
+		backslash: 92;
+		lowerA: 97;
+		one: 49;
+		two: 50;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	tokens := map[string]grammars.Token{}
+	for _, oneToken := range reference.Tokens().List() {
+		tokens[oneToken.Name()] = oneToken.Reference()
+	}
+
+	mutatorApp := mutators.NewApplication()
+	expected := map[string]map[string]bool{
+		"number": {
+			"1":   true,
+			"2":   true,
+			"21":  true,
+			"122": false,
+		},
+		"string": {
+			`""a"`:  false,
+			`"\"a"`: true,
+		},
+	}
+
+	for oneName, oneExpected := range expected {
+		token := tokens[oneName]
+		mutations, err := mutatorApp.Mutate(reference.Root(), token, token.Suites()[0].Content())
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		found := map[string]mutators.Mutation{}
+		for _, oneMutation := range mutations {
+			found[string(oneMutation.Content())] = oneMutation
+		}
+
+		for oneContent, isValid := range oneExpected {
+			mutation, ok := found[oneContent]
+			if !isValid && !ok {
+				t.Errorf("the token (name: %s) was expected to produce the mutation: %s", oneName, oneContent)
+				return
+			}
+
+			if ok && mutation.IsValid() != isValid {
+				t.Errorf("the mutation (%s) of the token (name: %s) was expected to be classified as valid: %t", oneContent, oneName, isValid)
+				return
+			}
+		}
+	}
+
+	mutations, err := mutatorApp.Mutate(reference.Root(), tokens["string"], []byte(`"\"a"`))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	isEscapeRemoved := false
+	for _, oneMutation := range mutations {
+		if oneMutation.Kind() == mutators.KindEscape && string(oneMutation.Content()) == `""a"` {
+			isEscapeRemoved = true
+		}
+	}
+
+	if !isEscapeRemoved {
+		t.Errorf("the escape of the string was expected to be removed")
+		return
+	}
+
+	suites, err := mutatorApp.Propose(reference.Root(), tokens["number"])
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	for _, oneSuite := range suites {
+		if oneSuite.IsValid() {
+			t.Errorf("the proposed suites were expected to be invalid")
+			return
+		}
+	}
+
+	if len(suites) <= 0 {
+		t.Errorf("invalid suites were expected to be proposed")
+		return
+	}
+
+	_, err = mutatorApp.Mutate(reference.Root(), tokens["number"], []byte("3"))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
@@ -0,0 +1,36 @@
+package mutators
+
+type mutation struct {
+	kind    uint8
+	content []byte
+	isValid bool
+}
+
+func createMutation(
+	kind uint8,
+	content []byte,
+	isValid bool,
+) Mutation {
+	out := mutation{
+		kind:    kind,
+		content: content,
+		isValid: isValid,
+	}
+
+	return &out
+}
+
+// Kind returns the kind of mutation
+func (obj *mutation) Kind() uint8 {
+	return obj.kind
+}
+
+// Content returns the mutated content
+func (obj *mutation) Content() []byte {
+	return obj.content
+}
+
+// IsValid returns true if the token still matches the mutated content, false otherwise
+func (obj *mutation) IsValid() bool {
+	return obj.isValid
+}
@@ -0,0 +1,39 @@
+package mutators
+
+import (
+	"github.com/steve-care-software/grammars/applications"
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+// KindDeletion represents a mutation that deletes a byte
+const KindDeletion uint8 = 0
+
+// KindSwap represents a mutation that swaps two adjacent bytes
+const KindSwap uint8 = 1
+
+// KindCardinality represents a mutation that repeats an element one time less than its minimum or one time more than its maximum
+const KindCardinality uint8 = 2
+
+// KindEscape represents a mutation that removes the escape of an escaped exception
+const KindEscape uint8 = 3
+
+// NewApplication creates a new mutator application
+func NewApplication() Application {
+	parser := applications.NewApplication()
+	grammarBuilder := grammars.NewBuilder()
+	suiteBuilder := grammars.NewSuiteBuilder()
+	return createApplication(parser, grammarBuilder, suiteBuilder)
+}
+
+// Application represents a mutator application
+type Application interface {
+	Mutate(grammar grammars.Grammar, token grammars.Token, content []byte) ([]Mutation, error)
+	Propose(grammar grammars.Grammar, token grammars.Token) ([]grammars.Suite, error)
+}
+
+// Mutation represents a near-miss of a valid content
+type Mutation interface {
+	Kind() uint8
+	Content() []byte
+	IsValid() bool
+}
@@ -0,0 +1,36 @@
+package applications
+
+type origin struct {
+	offset uint
+	line   uint
+	column uint
+}
+
+func createOrigin(
+	offset uint,
+	line uint,
+	column uint,
+) *origin {
+	out := origin{
+		offset: offset,
+		line:   line,
+		column: column,
+	}
+
+	return &out
+}
+
+// Offset returns the byte offset
+func (obj *origin) Offset() uint {
+	return obj.offset
+}
+
+// Line returns the line, starting at 1
+func (obj *origin) Line() uint {
+	return obj.line
+}
+
+// Column returns the column, starting at 1
+func (obj *origin) Column() uint {
+	return obj.column
+}
@@ -0,0 +1,174 @@
+package applications
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/references"
+	"github.com/steve-care-software/grammars/domain/trees"
+)
+
+const maxExpectationsInMessage = 10
+
+type parseError struct {
+	input        []byte
+	location     trees.Location
+	expectations []Expectation
+}
+
+func createParseError(
+	input []byte,
+	location trees.Location,
+	expectations []Expectation,
+) ParseError {
+	out := parseError{
+		input:        input,
+		location:     location,
+		expectations: expectations,
+	}
+
+	return &out
+}
+
+// Error returns the error message
+func (obj *parseError) Error() string {
+	location := obj.location
+	str := fmt.Sprintf("the input could not be parsed at line %d, column %d (offset: %d)", location.Line(), location.Column(), location.Offset())
+	expected := obj.Expected(nil)
+	if len(expected) > 0 {
+		if len(expected) > maxExpectationsInMessage {
+			amount := len(expected) - maxExpectationsInMessage
+			expected = append(expected[:maxExpectationsInMessage], fmt.Sprintf("... and %d more", amount))
+		}
+
+		str = fmt.Sprintf("%s, expected:\n\t%s", str, strings.Join(expected, "\n\t"))
+	}
+
+	return fmt.Sprintf("%s\n%s", str, obj.Snippet())
+}
+
+// Location returns the furthest location reached
+func (obj *parseError) Location() trees.Location {
+	return obj.location
+}
+
+// Expectations returns the elements expected at the furthest location
+func (obj *parseError) Expectations() []Expectation {
+	return obj.expectations
+}
+
+// Snippet returns the line of the furthest location with a caret under its column
+func (obj *parseError) Snippet() string {
+	offset := obj.location.Offset()
+	start := bytes.LastIndexByte(obj.input[:offset], '\n') + 1
+	end := len(obj.input)
+	if index := bytes.IndexByte(obj.input[offset:], '\n'); index >= 0 {
+		end = int(offset) + index
+	}
+
+	caret := []byte{}
+	for _, oneByte := range obj.input[start:offset] {
+		if oneByte == '\t' {
+			caret = append(caret, oneByte)
+			continue
+		}
+
+		caret = append(caret, ' ')
+	}
+
+	line := bytes.TrimRight(obj.input[start:end], "\r")
+	return fmt.Sprintf("%s\n%s^", line, caret)
+}
+
+// Expected returns the outermost expectations, named using the reference when provided
+func (obj *parseError) Expected(reference references.Reference) []string {
+	output := []string{}
+	found := map[string]bool{}
+	for idx, oneExpectation := range obj.expectations {
+		if obj.hasAncestor(idx) {
+			continue
+		}
+
+		path := []string{}
+		for _, oneParent := range oneExpectation.Parents() {
+			path = append(path, obj.tokenName(reference, oneParent))
+		}
+
+		path = append(path, obj.tokenName(reference, oneExpectation.Token()))
+		element := obj.elementName(reference, oneExpectation.Element())
+		description := fmt.Sprintf("%s (line: %d): %s", strings.Join(path, " > "), oneExpectation.Line(), element)
+		if _, ok := found[description]; ok {
+			continue
+		}
+
+		found[description] = true
+		output = append(output, description)
+	}
+
+	return output
+}
+
+func (obj *parseError) hasAncestor(index int) bool {
+	expectation := obj.expectations[index]
+	parents := expectation.Parents()
+	for idx, oneExpectation := range obj.expectations {
+		if idx == index {
+			continue
+		}
+
+		ancestorParents := oneExpectation.Parents()
+		if len(ancestorParents) >= len(parents) {
+			continue
+		}
+
+		isAncestor := oneExpectation.Token().Hash().Compare(parents[len(ancestorParents)].Hash())
+		for parentIdx, oneParent := range ancestorParents {
+			if !oneParent.Hash().Compare(parents[parentIdx].Hash()) {
+				isAncestor = false
+				break
+			}
+		}
+
+		if isAncestor {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (obj *parseError) elementName(reference references.Reference, element grammars.Element) string {
+	content := element.Content()
+	if content.IsValue() {
+		return fmt.Sprintf("%q", content.Value())
+	}
+
+	if content.IsRecursive() {
+		return content.Recursive()
+	}
+
+	if content.IsGrammar() {
+		return obj.tokenName(reference, content.Grammar().Root())
+	}
+
+	instance := content.Instance()
+	if instance.IsToken() {
+		return obj.tokenName(reference, instance.Token())
+	}
+
+	return fmt.Sprintf("#%s", obj.tokenName(reference, instance.Everything().Exception()))
+}
+
+func (obj *parseError) tokenName(reference references.Reference, token grammars.Token) string {
+	if reference != nil {
+		return reference.Tokens().Name(token)
+	}
+
+	if token.HasName() {
+		return token.Name()
+	}
+
+	return token.Hash().String()
+}
@@ -1,14 +1,40 @@
 package applications
 
 import (
+	"context"
+	"io"
+
 	grammars "github.com/steve-care-software/grammars/domain"
 	"github.com/steve-care-software/grammars/domain/references"
 	"github.com/steve-care-software/grammars/domain/references/coverages"
 	"github.com/steve-care-software/grammars/domain/trees"
 )
 
+// LimitSteps represents the limit of token parsing steps
+const LimitSteps uint8 = 0
+
+// LimitDepth represents the limit of nested tokens
+const LimitDepth uint8 = 1
+
+// LimitNodes represents the limit of tree nodes
+const LimitNodes uint8 = 2
+
+// NewBuilder creates a new application builder
+func NewBuilder() Builder {
+	return createBuilder()
+}
+
+// NewLimitsBuilder creates a new limits builder
+func NewLimitsBuilder() LimitsBuilder {
+	return createLimitsBuilder()
+}
+
 // NewApplication creates a new application instance
 func NewApplication() Application {
+	return newApplication(nil, false, false)
+}
+
+func newApplication(pMemoLimit *uint, isLongestMatch bool, isStrict bool) Application {
 	grammarTokenBuilder := grammars.NewTokenBuilder()
 	treesBuilder := trees.NewBuilder()
 	treeBuilder := trees.NewTreeBuilder()
@@ -17,11 +43,15 @@ func NewApplication() Application {
 	treeElementBuilder := trees.NewElementBuilder()
 	treeContentBuilder := trees.NewContentBuilder()
 	treeValueBuilder := trees.NewValueBuilder()
+	treePositionBuilder := trees.NewPositionBuilder()
 	coveragesBuilder := coverages.NewBuilder()
 	coverageBuilder := coverages.NewCoverageBuilder()
 	coverageExecutionsBuilder := coverages.NewExecutionsBuilder()
 	coverageExecutionBuilder := coverages.NewExecutionBuilder()
 	coverageResultBuilder := coverages.NewResultBuilder()
+	coverageTokenBuilder := coverages.NewTokenBuilder()
+	coverageLineBuilder := coverages.NewLineBuilder()
+	coverageElementBuilder := coverages.NewElementBuilder()
 	return createApplication(
 		grammarTokenBuilder,
 		treesBuilder,
@@ -31,16 +61,91 @@ func NewApplication() Application {
 		treeElementBuilder,
 		treeContentBuilder,
 		treeValueBuilder,
+		treePositionBuilder,
 		coveragesBuilder,
 		coverageBuilder,
 		coverageExecutionsBuilder,
 		coverageExecutionBuilder,
 		coverageResultBuilder,
+		coverageTokenBuilder,
+		coverageLineBuilder,
+		coverageElementBuilder,
+		pMemoLimit,
+		isLongestMatch,
+		isStrict,
 	)
 }
 
+// Builder represents an application builder
+type Builder interface {
+	Create() Builder
+	WithMemoLimit(memoLimit uint) Builder
+	IsLongestMatch() Builder
+	IsStrict() Builder
+	Now() (Application, error)
+}
+
 // Application represents the AST application
 type Application interface {
 	Execute(grammar grammars.Grammar, values []byte) (trees.Tree, error)
+	ExecuteComplete(grammar grammars.Grammar, values []byte) (trees.Tree, error)
+	ExecuteContext(ctx context.Context, grammar grammars.Grammar, values []byte, limits Limits) (trees.Tree, error)
 	Coverages(reference references.Reference) (coverages.Coverages, error)
+	Ambiguities(grammar grammars.Grammar, values []byte) ([]Ambiguity, error)
+	Stream(grammar grammars.Grammar, reader io.Reader, bufferSize uint) (Stream, error)
+}
+
+// Stream represents root trees parsed incrementally from a reader, their positions are relative to the start of the stream
+type Stream interface {
+	Next() (trees.Tree, error)
+}
+
+// LimitsBuilder represents a limits builder
+type LimitsBuilder interface {
+	Create() LimitsBuilder
+	WithSteps(steps uint) LimitsBuilder
+	WithDepth(depth uint) LimitsBuilder
+	WithNodes(nodes uint) LimitsBuilder
+	Now() (Limits, error)
+}
+
+// Limits represents the resource limits of an execution
+type Limits interface {
+	HasSteps() bool
+	Steps() *uint
+	HasDepth() bool
+	Depth() *uint
+	HasNodes() bool
+	Nodes() *uint
+}
+
+// LimitError represents an error returned when an execution exceeds one of its limits
+type LimitError interface {
+	error
+	Kind() uint8
+	Limit() uint
+}
+
+// Ambiguity represents a span of the input matched by more than one line of a token
+type Ambiguity interface {
+	Token() grammars.Token
+	Position() trees.Position
+	Lines() []trees.Line
+}
+
+// ParseError represents an error returned when an input could not be parsed
+type ParseError interface {
+	error
+	Location() trees.Location
+	Expectations() []Expectation
+	Snippet() string
+	Expected(reference references.Reference) []string
+}
+
+// Expectation represents an element expected at the furthest location of a parse error
+type Expectation interface {
+	Token() grammars.Token
+	Line() uint
+	Element() grammars.Element
+	Parents() []grammars.Token
 }
@@ -0,0 +1,123 @@
+package applications
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/trees"
+)
+
+type stream struct {
+	application *application
+	grammar     grammars.Grammar
+	reader      io.Reader
+	size        uint
+	capacity    uint
+	buffer      []byte
+	origin      *origin
+	isEOF       bool
+}
+
+func createStream(
+	application *application,
+	grammar grammars.Grammar,
+	reader io.Reader,
+	size uint,
+) Stream {
+	out := stream{
+		application: application,
+		grammar:     grammar,
+		reader:      reader,
+		size:        size,
+		capacity:    size,
+		buffer:      []byte{},
+		origin:      createOrigin(0, 1, 1),
+		isEOF:       false,
+	}
+
+	return &out
+}
+
+// Next parses the next root tree of the stream, where positions are relative to the start of the stream, returns io.EOF once the stream is entirely consumed
+func (obj *stream) Next() (trees.Tree, error) {
+	for {
+		err := obj.fill()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(obj.buffer) <= 0 {
+			return nil, io.EOF
+		}
+
+		execution := createExecution(obj.buffer, obj.application.pMemoLimit)
+		execution.origin = obj.origin
+		tree, err := obj.application.executeFrom(obj.grammar, execution, false)
+
+		// the data that follows the buffer could change the tree once the parsing reached the end of the buffer:
+		if !obj.isEOF && obj.isAtEnd(execution, tree, err) {
+			obj.capacity += obj.size
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		consumed := len(obj.buffer)
+		if tree.HasRemaining() {
+			consumed -= len(tree.Remaining())
+		}
+
+		if consumed <= 0 {
+			return nil, errors.New("the tree was expected to consume data from the stream")
+		}
+
+		// the consumed data is discarded, the tree keeps its own data:
+		obj.origin = obj.advance(obj.buffer[:consumed])
+		obj.buffer = append([]byte{}, obj.buffer[consumed:]...)
+		obj.capacity = obj.size
+		return tree, nil
+	}
+}
+
+// isAtEnd returns true if the parsing failed, consumed the whole buffer or failed on its end, false otherwise
+func (obj *stream) isAtEnd(execution *execution, tree trees.Tree, err error) bool {
+	if err != nil || !tree.HasRemaining() {
+		return true
+	}
+
+	return len(execution.failures) > 0 && execution.furthest >= uint(len(obj.buffer))
+}
+
+// advance returns the origin that follows the consumed data
+func (obj *stream) advance(consumed []byte) *origin {
+	offset := obj.origin.offset + uint(len(consumed))
+	lastNewLine := bytes.LastIndexByte(consumed, '\n')
+	if lastNewLine < 0 {
+		return createOrigin(offset, obj.origin.line, obj.origin.column+uint(len(consumed)))
+	}
+
+	line := obj.origin.line + uint(bytes.Count(consumed, []byte("\n")))
+	return createOrigin(offset, line, uint(len(consumed)-lastNewLine))
+}
+
+func (obj *stream) fill() error {
+	for !obj.isEOF && uint(len(obj.buffer)) < obj.capacity {
+		data := make([]byte, obj.capacity-uint(len(obj.buffer)))
+		amount, err := obj.reader.Read(data)
+		obj.buffer = append(obj.buffer, data[:amount]...)
+		if err == io.EOF {
+			obj.isEOF = true
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+package validators
+
+import (
+	"fmt"
+
+	"github.com/steve-care-software/grammars/applications/analyses"
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/references"
+)
+
+type application struct {
+	analysisApp analyses.Application
+}
+
+type validation struct {
+	reference references.Reference
+	analysis  analyses.Analysis
+	tokens    []grammars.Token
+	channels  []grammars.Token
+	visited   map[string]bool
+	findings  []Finding
+}
+
+func createApplication(
+	analysisApp analyses.Application,
+) Application {
+	out := application{
+		analysisApp: analysisApp,
+	}
+
+	return &out
+}
+
+// Validate returns the problems found in the grammar of the reference, assuming the first matching line of a token is selected
+func (app *application) Validate(reference references.Reference) []Finding {
+	grammar := reference.Root()
+	state := &validation{
+		reference: reference,
+		analysis:  app.analysisApp.Analyze(grammar),
+		tokens:    []grammars.Token{},
+		channels:  []grammars.Token{},
+		visited:   map[string]bool{},
+		findings:  []Finding{},
+	}
+
+	app.grammar(state, grammar)
+	for _, oneToken := range state.tokens {
+		app.validate(state, oneToken)
+	}
+
+	for _, oneChannel := range state.channels {
+		analysis, err := state.analysis.Fetch(oneChannel)
+		if err != nil || !analysis.IsNullable() {
+			continue
+		}
+
+		name := state.reference.Tokens().Name(oneChannel)
+		str := fmt.Sprintf("the channel (name: %s) can match an empty input", name)
+		state.findings = append(state.findings, createFinding(KindNullableChannel, name, str))
+	}
+
+	return state.findings
+}
+
+func (app *application) grammar(state *validation, grammar grammars.Grammar) {
+	app.token(state, grammar.Root(), []grammars.Token{})
+	if !grammar.HasChannels() {
+		return
+	}
+
+	for _, oneChannel := range grammar.Channels() {
+		token := oneChannel.Token()
+		state.channels = append(state.channels, token)
+		app.token(state, token, []grammars.Token{})
+	}
+}
+
+func (app *application) token(state *validation, token grammars.Token, stack []grammars.Token) {
+	keyname := string(token.Hash())
+	if state.visited[keyname] {
+		return
+	}
+
+	state.visited[keyname] = true
+	state.tokens = append(state.tokens, token)
+
+	enclosing := make([]grammars.Token, len(stack), len(stack)+1)
+	copy(enclosing, stack)
+	enclosing = append(enclosing, token)
+	for lineIdx, oneLine := range token.Lines() {
+		for elementIdx, oneElement := range oneLine.Elements() {
+			content := oneElement.Content()
+			if content.IsGrammar() {
+				app.grammar(state, content.Grammar())
+				continue
+			}
+
+			if content.IsRecursive() {
+				recursive := content.Recursive()
+				if app.isEnclosed(state, recursive, enclosing) {
+					continue
+				}
+
+				pLine, pElement := uint(lineIdx), uint(elementIdx)
+				name := state.reference.Tokens().Name(token)
+				str := fmt.Sprintf("the recursive name (%s) of the token (name: %s) does not match any enclosing token", recursive, name)
+				state.findings = append(state.findings, createFindingWithLineAndElement(KindRecursive, name, &pLine, &pElement, str))
+				continue
+			}
+
+			if !content.IsInstance() {
+				continue
+			}
+
+			instance := content.Instance()
+			if instance.IsToken() {
+				app.token(state, instance.Token(), enclosing)
+				continue
+			}
+
+			everything := instance.Everything()
+			app.token(state, everything.Exception(), enclosing)
+			if everything.HasEscape() {
+				app.token(state, everything.Escape(), enclosing)
+			}
+		}
+	}
+}
+
+func (app *application) isEnclosed(state *validation, name string, enclosing []grammars.Token) bool {
+	for _, oneToken := range enclosing {
+		if oneToken.HasName() && oneToken.Name() == name {
+			return true
+		}
+
+		if state.reference.Tokens().Name(oneToken) == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (app *application) validate(state *validation, token grammars.Token) {
+	name := state.reference.Tokens().Name(token)
+	lines := token.Lines()
+	if len(lines) <= 0 {
+		str := fmt.Sprintf("the token (name: %s) does not contain any line", name)
+		state.findings = append(state.findings, createFinding(KindEmpty, name, str))
+		return
+	}
+
+	for lineIdx, oneLine := range lines {
+		pLine := uint(lineIdx)
+		elements := oneLine.Elements()
+		if len(elements) <= 0 {
+			str := fmt.Sprintf("the line (index: %d) of the token (name: %s) does not contain any element", lineIdx, name)
+			state.findings = append(state.findings, createFindingWithLine(KindEmpty, name, &pLine, str))
+			continue
+		}
+
+		for elementIdx, oneElement := range elements {
+			pElement := uint(elementIdx)
+			cardinality := oneElement.Cardinality()
+			if cardinality.HasMax() && *cardinality.Max() < cardinality.Min() {
+				str := fmt.Sprintf("the element (index: %d) of the line (index: %d) of the token (name: %s) has a maximum (%d) smaller than its minimum (%d)", elementIdx, lineIdx, name, *cardinality.Max(), cardinality.Min())
+				state.findings = append(state.findings, createFindingWithLineAndElement(KindCardinality, name, &pLine, &pElement, str))
+			}
+
+			if !cardinality.HasMax() && state.analysis.IsNullable(oneElement.Content()) {
+				str := fmt.Sprintf("the element (index: %d) of the line (index: %d) of the token (name: %s) is repeated without maximum but its content can match an empty input", elementIdx, lineIdx, name)
+				state.findings = append(state.findings, createFindingWithLineAndElement(KindNullableLoop, name, &pLine, &pElement, str))
+			}
+		}
+
+		for previousIdx := 0; previousIdx < lineIdx; previousIdx++ {
+			if !app.isPrefix(lines[previousIdx], oneLine) {
+				continue
+			}
+
+			str := fmt.Sprintf("the line (index: %d) of the token (name: %s) can never match because the line (index: %d) always matches its prefix", lineIdx, name, previousIdx)
+			state.findings = append(state.findings, createFindingWithLine(KindShadowedLine, name, &pLine, str))
+			break
+		}
+	}
+}
+
+// isPrefix returns true if the elements of the previous line are the first elements of the line, and the previous line cannot match without content
+func (app *application) isPrefix(previous grammars.Line, line grammars.Line) bool {
+	prefix := previous.Elements()
+	elements := line.Elements()
+	if len(prefix) <= 0 || len(prefix) > len(elements) {
+		return false
+	}
+
+	isMandatory := false
+	for idx, oneElement := range prefix {
+		if !oneElement.Hash().Compare(elements[idx].Hash()) {
+			return false
+		}
+
+		if oneElement.Cardinality().Min() > 0 {
+			isMandatory = true
+		}
+	}
+
+	return isMandatory
+}
@@ -0,0 +1,60 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/steve-care-software/grammars/applications/validators"
+	"github.com/steve-care-software/grammars/infrastructure/scripts"
+)
+
+func TestValidator_Success(t *testing.T) {
+	script := `
+		@list;
+		-blanks;
+
+		list: item* number[3,1];
+		item: one? two?;
+		number: one | one two;
+		blanks: space*;
+
+		one: 49;
+		two: 50;
+		space: 32;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := []struct {
+		kind  uint8
+		token string
+	}{
+		{kind: validators.KindNullableLoop, token: "list"},
+		{kind: validators.KindCardinality, token: "list"},
+		{kind: validators.KindShadowedLine, token: "number"},
+		{kind: validators.KindNullableChannel, token: "blanks"},
+	}
+
+	application := validators.NewApplication()
+	findings := application.Validate(reference)
+	if len(findings) != len(expected) {
+		t.Errorf("%d findings were expected, %d returned", len(expected), len(findings))
+		return
+	}
+
+	for idx, oneFinding := range findings {
+		if oneFinding.Kind() != expected[idx].kind || oneFinding.Token() != expected[idx].token {
+			t.Errorf("the finding (index: %d) was expected to be of kind %d on the token (name: %s), returned: %s", idx, expected[idx].kind, expected[idx].token, oneFinding.Message())
+			return
+		}
+	}
+
+	findings = application.Validate(scripts.NewGrammar().Grammar())
+	if len(findings) > 0 {
+		t.Errorf("the script grammar was expected to be valid, returned: %s", findings[0].Message())
+		return
+	}
+}
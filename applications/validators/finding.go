@@ -0,0 +1,89 @@
+package validators
+
+type finding struct {
+	kind     uint8
+	token    string
+	pLine    *uint
+	pElement *uint
+	message  string
+}
+
+func createFinding(
+	kind uint8,
+	token string,
+	message string,
+) Finding {
+	return createFindingInternally(kind, token, nil, nil, message)
+}
+
+func createFindingWithLine(
+	kind uint8,
+	token string,
+	pLine *uint,
+	message string,
+) Finding {
+	return createFindingInternally(kind, token, pLine, nil, message)
+}
+
+func createFindingWithLineAndElement(
+	kind uint8,
+	token string,
+	pLine *uint,
+	pElement *uint,
+	message string,
+) Finding {
+	return createFindingInternally(kind, token, pLine, pElement, message)
+}
+
+func createFindingInternally(
+	kind uint8,
+	token string,
+	pLine *uint,
+	pElement *uint,
+	message string,
+) Finding {
+	out := finding{
+		kind:     kind,
+		token:    token,
+		pLine:    pLine,
+		pElement: pElement,
+		message:  message,
+	}
+
+	return &out
+}
+
+// Kind returns the kind of finding
+func (obj *finding) Kind() uint8 {
+	return obj.kind
+}
+
+// Token returns the name of the token
+func (obj *finding) Token() string {
+	return obj.token
+}
+
+// HasLine returns true if there is a line, false otherwise
+func (obj *finding) HasLine() bool {
+	return obj.pLine != nil
+}
+
+// Line returns the index of the line, if any
+func (obj *finding) Line() *uint {
+	return obj.pLine
+}
+
+// HasElement returns true if there is an element, false otherwise
+func (obj *finding) HasElement() bool {
+	return obj.pElement != nil
+}
+
+// Element returns the index of the element in its line, if any
+func (obj *finding) Element() *uint {
+	return obj.pElement
+}
+
+// Message returns the message
+func (obj *finding) Message() string {
+	return obj.message
+}
@@ -0,0 +1,46 @@
+package validators
+
+import (
+	"github.com/steve-care-software/grammars/applications/analyses"
+	"github.com/steve-care-software/grammars/domain/references"
+)
+
+// KindRecursive represents a recursive element name that does not match any enclosing token
+const KindRecursive uint8 = 0
+
+// KindEmpty represents a token without lines, or a line without elements
+const KindEmpty uint8 = 1
+
+// KindCardinality represents a cardinality whose maximum is smaller than its minimum
+const KindCardinality uint8 = 2
+
+// KindShadowedLine represents a line that can never match because an earlier line of its token always matches its prefix
+const KindShadowedLine uint8 = 3
+
+// KindNullableLoop represents an unbounded element whose content can match an empty input
+const KindNullableLoop uint8 = 4
+
+// KindNullableChannel represents a channel whose token can match an empty input
+const KindNullableChannel uint8 = 5
+
+// NewApplication creates a new validator application
+func NewApplication() Application {
+	analysisApp := analyses.NewApplication()
+	return createApplication(analysisApp)
+}
+
+// Application represents a static grammar validator
+type Application interface {
+	Validate(reference references.Reference) []Finding
+}
+
+// Finding represents a problem found in a grammar
+type Finding interface {
+	Kind() uint8
+	Token() string
+	HasLine() bool
+	Line() *uint
+	HasElement() bool
+	Element() *uint
+	Message() string
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/steve-care-software/grammars/domain/references"
+	"github.com/steve-care-software/grammars/infrastructure/exporters"
+	"github.com/steve-care-software/grammars/infrastructure/scripts"
+)
+
+func main() {
+	output := flag.String("o", ".", "the directory where the SVG railroad diagrams are written")
+	language := flag.Bool("scripts", false, "draw the grammar of the scripts language instead of a script")
+	flag.Parse()
+
+	reference, err := load(*language)
+	if err != nil {
+		exit(err)
+	}
+
+	err = os.MkdirAll(*output, 0755)
+	if err != nil {
+		exit(err)
+	}
+
+	exporter := exporters.NewRailroad()
+	written := map[string]bool{}
+	for _, oneToken := range reference.Tokens().List() {
+		name := oneToken.Name()
+		if _, ok := written[name]; ok {
+			continue
+		}
+
+		svg, err := exporter.Export(reference, oneToken.Reference())
+		if err != nil {
+			exit(err)
+		}
+
+		path := filepath.Join(*output, fmt.Sprintf("%s.svg", name))
+		err = os.WriteFile(path, svg, 0644)
+		if err != nil {
+			exit(err)
+		}
+
+		written[name] = true
+	}
+}
+
+func load(language bool) (references.Reference, error) {
+	if language {
+		return scripts.NewGrammar().Grammar(), nil
+	}
+
+	var script []byte
+	var err error
+	if flag.NArg() <= 0 {
+		script, err = io.ReadAll(os.Stdin)
+	} else {
+		script, err = os.ReadFile(flag.Arg(0))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return scripts.NewCompiler().Compile(script)
+}
+
+func exit(err error) {
+	fmt.Fprintln(os.Stderr, err.Error())
+	os.Exit(1)
+}
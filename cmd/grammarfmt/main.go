@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/steve-care-software/grammars/infrastructure/scripts"
+)
+
+func main() {
+	write := flag.Bool("w", false, "write the result to the source file instead of the standard output")
+	list := flag.Bool("l", false, "list the files whose formatting differs from the canonical layout")
+	flag.Parse()
+
+	formatter := scripts.NewFormatter()
+	if flag.NArg() <= 0 {
+		script, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			exit(err)
+		}
+
+		formatted, err := formatter.Format(script)
+		if err != nil {
+			exit(err)
+		}
+
+		os.Stdout.Write(formatted)
+		return
+	}
+
+	hasError := false
+	for _, onePath := range flag.Args() {
+		err := format(formatter, onePath, *write, *list)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", onePath, err.Error())
+			hasError = true
+		}
+	}
+
+	if hasError {
+		os.Exit(1)
+	}
+}
+
+func format(formatter scripts.Formatter, path string, write bool, list bool) error {
+	script, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	formatted, err := formatter.Format(script)
+	if err != nil {
+		return err
+	}
+
+	if !list && !write {
+		_, err = os.Stdout.Write(formatted)
+		return err
+	}
+
+	if bytes.Equal(script, formatted) {
+		return nil
+	}
+
+	if list {
+		fmt.Println(path)
+	}
+
+	if write {
+		return os.WriteFile(path, formatted, 0644)
+	}
+
+	return nil
+}
+
+func exit(err error) {
+	fmt.Fprintln(os.Stderr, err.Error())
+	os.Exit(1)
+}
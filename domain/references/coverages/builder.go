@@ -3,12 +3,14 @@ package coverages
 import "errors"
 
 type builder struct {
-	list []Coverage
+	list   []Coverage
+	tokens []Token
 }
 
 func createBuilder() Builder {
 	out := builder{
-		list: nil,
+		list:   nil,
+		tokens: nil,
 	}
 
 	return &out
@@ -25,6 +27,12 @@ func (app *builder) WithList(list []Coverage) Builder {
 	return app
 }
 
+// WithTokens add tokens to the builder
+func (app *builder) WithTokens(tokens []Token) Builder {
+	app.tokens = tokens
+	return app
+}
+
 // Now builds a new Coverages instance
 func (app *builder) Now() (Coverages, error) {
 	if app.list != nil && len(app.list) <= 0 {
@@ -35,5 +43,13 @@ func (app *builder) Now() (Coverages, error) {
 		return nil, errors.New("there must be at least 1 Coverage in order to build a Coverages instance")
 	}
 
+	if app.tokens != nil && len(app.tokens) <= 0 {
+		app.tokens = nil
+	}
+
+	if app.tokens != nil {
+		return createCoveragesWithTokens(app.list, app.tokens), nil
+	}
+
 	return createCoverages(app.list), nil
 }
@@ -28,3 +28,13 @@ func (obj *coverage) Token() references.Token {
 func (obj *coverage) Executions() Executions {
 	return obj.executions
 }
+
+// Passed returns the amount of passed executions
+func (obj *coverage) Passed() uint {
+	return obj.executions.Passed()
+}
+
+// Failed returns the amount of failed executions
+func (obj *coverage) Failed() uint {
+	return obj.executions.Failed()
+}
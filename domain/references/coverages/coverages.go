@@ -1,14 +1,30 @@
 package coverages
 
 type coverages struct {
-	list []Coverage
+	list   []Coverage
+	tokens []Token
 }
 
 func createCoverages(
 	list []Coverage,
+) Coverages {
+	return createCoveragesInternally(list, nil)
+}
+
+func createCoveragesWithTokens(
+	list []Coverage,
+	tokens []Token,
+) Coverages {
+	return createCoveragesInternally(list, tokens)
+}
+
+func createCoveragesInternally(
+	list []Coverage,
+	tokens []Token,
 ) Coverages {
 	out := coverages{
-		list: list,
+		list:   list,
+		tokens: tokens,
 	}
 
 	return &out
@@ -19,7 +35,7 @@ func (obj *coverages) List() []Coverage {
 	return obj.list
 }
 
-// ContainsError returns true if it contains an error, false otherwise
+// ContainsError returns true if it contains an execution that did not pass, false otherwise
 func (obj *coverages) ContainsError() bool {
 	for _, oneCoverage := range obj.list {
 		if !oneCoverage.Executions().ContainsError() {
@@ -31,3 +47,33 @@ func (obj *coverages) ContainsError() bool {
 
 	return false
 }
+
+// Passed returns the amount of passed executions
+func (obj *coverages) Passed() uint {
+	amount := uint(0)
+	for _, oneCoverage := range obj.list {
+		amount += oneCoverage.Passed()
+	}
+
+	return amount
+}
+
+// Failed returns the amount of failed executions
+func (obj *coverages) Failed() uint {
+	amount := uint(0)
+	for _, oneCoverage := range obj.list {
+		amount += oneCoverage.Failed()
+	}
+
+	return amount
+}
+
+// HasTokens returns true if there is tokens, false otherwise
+func (obj *coverages) HasTokens() bool {
+	return obj.tokens != nil
+}
+
+// Tokens returns the tokens, if any
+func (obj *coverages) Tokens() []Token {
+	return obj.tokens
+}
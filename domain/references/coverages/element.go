@@ -0,0 +1,38 @@
+package coverages
+
+import grammars "github.com/steve-care-software/grammars/domain"
+
+type element struct {
+	index     uint
+	reference grammars.Element
+	isCovered bool
+}
+
+func createElement(
+	index uint,
+	reference grammars.Element,
+	isCovered bool,
+) Element {
+	out := element{
+		index:     index,
+		reference: reference,
+		isCovered: isCovered,
+	}
+
+	return &out
+}
+
+// Index returns the index
+func (obj *element) Index() uint {
+	return obj.index
+}
+
+// Reference returns the reference
+func (obj *element) Reference() grammars.Element {
+	return obj.reference
+}
+
+// IsCovered returns true if the element was exercised, false otherwise
+func (obj *element) IsCovered() bool {
+	return obj.isCovered
+}
@@ -0,0 +1,59 @@
+package coverages
+
+import (
+	"errors"
+
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+type elementBuilder struct {
+	pIndex    *uint
+	reference grammars.Element
+	isCovered bool
+}
+
+func createElementBuilder() ElementBuilder {
+	out := elementBuilder{
+		pIndex:    nil,
+		reference: nil,
+		isCovered: false,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *elementBuilder) Create() ElementBuilder {
+	return createElementBuilder()
+}
+
+// WithIndex adds an index to the builder
+func (app *elementBuilder) WithIndex(index uint) ElementBuilder {
+	app.pIndex = &index
+	return app
+}
+
+// WithReference adds a reference to the builder
+func (app *elementBuilder) WithReference(reference grammars.Element) ElementBuilder {
+	app.reference = reference
+	return app
+}
+
+// IsCovered flags the builder as covered
+func (app *elementBuilder) IsCovered() ElementBuilder {
+	app.isCovered = true
+	return app
+}
+
+// Now builds a new Element instance
+func (app *elementBuilder) Now() (Element, error) {
+	if app.pIndex == nil {
+		return nil, errors.New("the index is mandatory in order to build an Element instance")
+	}
+
+	if app.reference == nil {
+		return nil, errors.New("the reference is mandatory in order to build an Element instance")
+	}
+
+	return createElement(*app.pIndex, app.reference, app.isCovered), nil
+}
@@ -28,3 +28,10 @@ func (obj *execution) Expectation() grammars.Suite {
 func (obj *execution) Result() Result {
 	return obj.result
 }
+
+// Passed returns true if the result matches the expectation, false otherwise. A valid suite must be parsed
+// without remaining data, an invalid suite must either fail or leave remaining data
+func (obj *execution) Passed() bool {
+	isComplete := obj.result.IsTree() && !obj.result.Tree().HasRemaining()
+	return obj.expectation.IsValid() == isComplete
+}
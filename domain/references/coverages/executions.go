@@ -19,15 +19,26 @@ func (obj *executions) List() []Execution {
 	return obj.list
 }
 
-// ContainsError returns true if it contains an error, false otherwise
+// ContainsError returns true if it contains an execution that did not pass, false otherwise
 func (obj *executions) ContainsError() bool {
+	return obj.Failed() > 0
+}
+
+// Passed returns the amount of passed executions
+func (obj *executions) Passed() uint {
+	return uint(len(obj.list)) - obj.Failed()
+}
+
+// Failed returns the amount of failed executions
+func (obj *executions) Failed() uint {
+	amount := uint(0)
 	for _, oneExecution := range obj.list {
-		if !oneExecution.Result().IsError() {
+		if oneExecution.Passed() {
 			continue
 		}
 
-		return true
+		amount++
 	}
 
-	return false
+	return amount
 }
@@ -0,0 +1,48 @@
+package coverages
+
+type line struct {
+	index     uint
+	elements  []Element
+	isCovered bool
+}
+
+func createLine(
+	index uint,
+	elements []Element,
+	isCovered bool,
+) Line {
+	out := line{
+		index:     index,
+		elements:  elements,
+		isCovered: isCovered,
+	}
+
+	return &out
+}
+
+// Index returns the index
+func (obj *line) Index() uint {
+	return obj.index
+}
+
+// Elements returns the elements
+func (obj *line) Elements() []Element {
+	return obj.elements
+}
+
+// IsCovered returns true if the line was exercised, false otherwise
+func (obj *line) IsCovered() bool {
+	return obj.isCovered
+}
+
+// Percentage returns the percentage of covered elements
+func (obj *line) Percentage() float64 {
+	covered := 0
+	for _, oneElement := range obj.elements {
+		if oneElement.IsCovered() {
+			covered++
+		}
+	}
+
+	return percentage(covered, len(obj.elements))
+}
@@ -0,0 +1,59 @@
+package coverages
+
+import "errors"
+
+type lineBuilder struct {
+	pIndex    *uint
+	elements  []Element
+	isCovered bool
+}
+
+func createLineBuilder() LineBuilder {
+	out := lineBuilder{
+		pIndex:    nil,
+		elements:  nil,
+		isCovered: false,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *lineBuilder) Create() LineBuilder {
+	return createLineBuilder()
+}
+
+// WithIndex adds an index to the builder
+func (app *lineBuilder) WithIndex(index uint) LineBuilder {
+	app.pIndex = &index
+	return app
+}
+
+// WithElements add elements to the builder
+func (app *lineBuilder) WithElements(elements []Element) LineBuilder {
+	app.elements = elements
+	return app
+}
+
+// IsCovered flags the builder as covered
+func (app *lineBuilder) IsCovered() LineBuilder {
+	app.isCovered = true
+	return app
+}
+
+// Now builds a new Line instance
+func (app *lineBuilder) Now() (Line, error) {
+	if app.pIndex == nil {
+		return nil, errors.New("the index is mandatory in order to build a Line instance")
+	}
+
+	if app.elements != nil && len(app.elements) <= 0 {
+		app.elements = nil
+	}
+
+	if app.elements == nil {
+		return nil, errors.New("there must be at least 1 Element in order to build a Line instance")
+	}
+
+	return createLine(*app.pIndex, app.elements, app.isCovered), nil
+}
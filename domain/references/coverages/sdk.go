@@ -31,10 +31,26 @@ func NewResultBuilder() ResultBuilder {
 	return createResultBuilder()
 }
 
+// NewTokenBuilder creates a new token builder
+func NewTokenBuilder() TokenBuilder {
+	return createTokenBuilder()
+}
+
+// NewLineBuilder creates a new line builder
+func NewLineBuilder() LineBuilder {
+	return createLineBuilder()
+}
+
+// NewElementBuilder creates a new element builder
+func NewElementBuilder() ElementBuilder {
+	return createElementBuilder()
+}
+
 // Builder represents a coverages builder
 type Builder interface {
 	Create() Builder
 	WithList(list []Coverage) Builder
+	WithTokens(tokens []Token) Builder
 	Now() (Coverages, error)
 }
 
@@ -42,6 +58,10 @@ type Builder interface {
 type Coverages interface {
 	List() []Coverage
 	ContainsError() bool
+	Passed() uint
+	Failed() uint
+	HasTokens() bool
+	Tokens() []Token
 }
 
 // CoverageBuilder represents a coverage builder
@@ -56,6 +76,8 @@ type CoverageBuilder interface {
 type Coverage interface {
 	Token() references.Token
 	Executions() Executions
+	Passed() uint
+	Failed() uint
 }
 
 // ExecutionsBuilder represents an executions builder
@@ -69,6 +91,8 @@ type ExecutionsBuilder interface {
 type Executions interface {
 	List() []Execution
 	ContainsError() bool
+	Passed() uint
+	Failed() uint
 }
 
 // ExecutionBuilder represents an execution builder
@@ -83,6 +107,7 @@ type ExecutionBuilder interface {
 type Execution interface {
 	Expectation() grammars.Suite
 	Result() Result
+	Passed() bool
 }
 
 // ResultBuilder represents a result builder
@@ -100,3 +125,52 @@ type Result interface {
 	IsError() bool
 	Error() string
 }
+
+// TokenBuilder represents a token coverage builder
+type TokenBuilder interface {
+	Create() TokenBuilder
+	WithReference(reference references.Token) TokenBuilder
+	WithLines(lines []Line) TokenBuilder
+	Now() (Token, error)
+}
+
+// Token represents the lines and elements of a token exercised by the passing suites
+type Token interface {
+	Reference() references.Token
+	Lines() []Line
+	LinesPercentage() float64
+	ElementsPercentage() float64
+}
+
+// LineBuilder represents a line coverage builder
+type LineBuilder interface {
+	Create() LineBuilder
+	WithIndex(index uint) LineBuilder
+	WithElements(elements []Element) LineBuilder
+	IsCovered() LineBuilder
+	Now() (Line, error)
+}
+
+// Line represents a line coverage
+type Line interface {
+	Index() uint
+	Elements() []Element
+	IsCovered() bool
+	Percentage() float64
+}
+
+// ElementBuilder represents an element coverage builder
+type ElementBuilder interface {
+	Create() ElementBuilder
+	WithIndex(index uint) ElementBuilder
+	WithReference(reference grammars.Element) ElementBuilder
+	IsCovered() ElementBuilder
+	Now() (Element, error)
+}
+
+// Element represents an element coverage
+type Element interface {
+	Index() uint
+	Reference() grammars.Element
+	IsCovered() bool
+}
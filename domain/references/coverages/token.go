@@ -0,0 +1,68 @@
+package coverages
+
+import "github.com/steve-care-software/grammars/domain/references"
+
+type token struct {
+	reference references.Token
+	lines     []Line
+}
+
+func createToken(
+	reference references.Token,
+	lines []Line,
+) Token {
+	out := token{
+		reference: reference,
+		lines:     lines,
+	}
+
+	return &out
+}
+
+// Reference returns the reference
+func (obj *token) Reference() references.Token {
+	return obj.reference
+}
+
+// Lines returns the lines
+func (obj *token) Lines() []Line {
+	return obj.lines
+}
+
+// LinesPercentage returns the percentage of covered lines
+func (obj *token) LinesPercentage() float64 {
+	covered := 0
+	for _, oneLine := range obj.lines {
+		if oneLine.IsCovered() {
+			covered++
+		}
+	}
+
+	return percentage(covered, len(obj.lines))
+}
+
+// ElementsPercentage returns the percentage of covered elements, in all lines
+func (obj *token) ElementsPercentage() float64 {
+	covered := 0
+	amount := 0
+	for _, oneLine := range obj.lines {
+		elements := oneLine.Elements()
+		for _, oneElement := range elements {
+			if oneElement.IsCovered() {
+				covered++
+			}
+		}
+
+		amount += len(elements)
+	}
+
+	return percentage(covered, amount)
+}
+
+func percentage(covered int, amount int) float64 {
+	if amount <= 0 {
+		return 0
+	}
+
+	return float64(covered) * 100 / float64(amount)
+}
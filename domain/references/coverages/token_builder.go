@@ -0,0 +1,55 @@
+package coverages
+
+import (
+	"errors"
+
+	"github.com/steve-care-software/grammars/domain/references"
+)
+
+type tokenBuilder struct {
+	reference references.Token
+	lines     []Line
+}
+
+func createTokenBuilder() TokenBuilder {
+	out := tokenBuilder{
+		reference: nil,
+		lines:     nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *tokenBuilder) Create() TokenBuilder {
+	return createTokenBuilder()
+}
+
+// WithReference adds a reference to the builder
+func (app *tokenBuilder) WithReference(reference references.Token) TokenBuilder {
+	app.reference = reference
+	return app
+}
+
+// WithLines add lines to the builder
+func (app *tokenBuilder) WithLines(lines []Line) TokenBuilder {
+	app.lines = lines
+	return app
+}
+
+// Now builds a new Token instance
+func (app *tokenBuilder) Now() (Token, error) {
+	if app.reference == nil {
+		return nil, errors.New("the reference is mandatory in order to build a Token instance")
+	}
+
+	if app.lines != nil && len(app.lines) <= 0 {
+		app.lines = nil
+	}
+
+	if app.lines == nil {
+		return nil, errors.New("there must be at least 1 Line in order to build a Token instance")
+	}
+
+	return createToken(app.reference, app.lines), nil
+}
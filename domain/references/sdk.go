@@ -58,6 +58,7 @@ type TokensBuilder interface {
 type Tokens interface {
 	List() []Token
 	Fetch(hash hash.Hash) (Token, error)
+	Name(token grammars.Token) string
 }
 
 // TokenBuilder represents the token builder
@@ -100,3 +101,13 @@ type Grammar interface {
 	Name() string
 	Reference() grammars.Grammar
 }
+
+// Repository represents a reference repository
+type Repository interface {
+	Retrieve(hash hash.Hash) (Reference, error)
+}
+
+// Service represents a reference service
+type Service interface {
+	Insert(reference Reference) error
+}
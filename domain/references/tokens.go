@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 
+	grammars "github.com/steve-care-software/grammars/domain"
 	"github.com/steve-care-software/libs/cryptography/hash"
 )
 
@@ -39,3 +40,16 @@ func (obj *tokens) Fetch(hash hash.Hash) (Token, error) {
 	str := fmt.Sprintf("the hash (name: %s) do not reference any token instance", hashStr)
 	return nil, errors.New(str)
 }
+
+// Name returns the name of the token in the tokens, or its own name when it is not referenced, or its hash when it has no name
+func (obj *tokens) Name(token grammars.Token) string {
+	if ins, ok := obj.mp[token.Hash().String()]; ok {
+		return ins.Name()
+	}
+
+	if token.HasName() {
+		return token.Name()
+	}
+
+	return token.Hash().String()
+}
@@ -115,6 +115,7 @@ type TokenBuilder interface {
 	Create() TokenBuilder
 	WithLines(lines []Line) TokenBuilder
 	WithSuites(suites []Suite) TokenBuilder
+	WithName(name string) TokenBuilder
 	Now() (Token, error)
 }
 
@@ -124,6 +125,8 @@ type Token interface {
 	Lines() []Line
 	HasSuites() bool
 	Suites() []Suite
+	HasName() bool
+	Name() string
 }
 
 // SuiteBuilder represents a suite builder
@@ -232,3 +235,17 @@ type Cardinality interface {
 	HasMax() bool
 	Max() *uint
 }
+
+// Repository represents a grammar repository
+type Repository interface {
+	Retrieve(hash hash.Hash) (Grammar, error)
+	RetrieveToken(hash hash.Hash) (Token, error)
+	RetrieveLine(hash hash.Hash) (Line, error)
+	RetrieveElement(hash hash.Hash) (Element, error)
+}
+
+// Service represents a grammar service
+type Service interface {
+	Insert(grammar Grammar) error
+	InsertToken(token Token) error
+}
@@ -6,32 +6,37 @@ type token struct {
 	hash   hash.Hash
 	lines  []Line
 	suites []Suite
+	name   string
 }
 
 func createToken(
 	hash hash.Hash,
 	lines []Line,
+	name string,
 ) Token {
-	return createTokenInternally(hash, lines, nil)
+	return createTokenInternally(hash, lines, nil, name)
 }
 
 func createTokenWithSuites(
 	hash hash.Hash,
 	lines []Line,
 	suites []Suite,
+	name string,
 ) Token {
-	return createTokenInternally(hash, lines, suites)
+	return createTokenInternally(hash, lines, suites, name)
 }
 
 func createTokenInternally(
 	hash hash.Hash,
 	lines []Line,
 	suites []Suite,
+	name string,
 ) Token {
 	out := token{
 		hash:   hash,
 		lines:  lines,
 		suites: suites,
+		name:   name,
 	}
 
 	return &out
@@ -56,3 +61,13 @@ func (obj *token) HasSuites() bool {
 func (obj *token) Suites() []Suite {
 	return obj.suites
 }
+
+// HasName returns true if there is a name, false otherwise
+func (obj *token) HasName() bool {
+	return obj.name != ""
+}
+
+// Name returns the name, if any
+func (obj *token) Name() string {
+	return obj.name
+}
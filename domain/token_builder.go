@@ -10,6 +10,7 @@ type tokenBuilder struct {
 	hashAdapter hash.Adapter
 	lines       []Line
 	suites      []Suite
+	name        string
 }
 
 func createTokenBuilder(
@@ -19,6 +20,7 @@ func createTokenBuilder(
 		hashAdapter: hashAdapter,
 		lines:       nil,
 		suites:      nil,
+		name:        "",
 	}
 
 	return &out
@@ -43,6 +45,12 @@ func (app *tokenBuilder) WithSuites(suites []Suite) TokenBuilder {
 	return app
 }
 
+// WithName adds a name to the builder
+func (app *tokenBuilder) WithName(name string) TokenBuilder {
+	app.name = name
+	return app
+}
+
 // Now builds a new Token instance
 func (app *tokenBuilder) Now() (Token, error) {
 	if app.lines != nil && len(app.lines) <= 0 {
@@ -68,14 +76,18 @@ func (app *tokenBuilder) Now() (Token, error) {
 		}
 	}
 
+	if app.name != "" {
+		data = append(data, []byte(app.name))
+	}
+
 	pHash, err := app.hashAdapter.FromMultiBytes(data)
 	if err != nil {
 		return nil, err
 	}
 
 	if app.suites != nil {
-		return createTokenWithSuites(*pHash, app.lines, app.suites), nil
+		return createTokenWithSuites(*pHash, app.lines, app.suites, app.name), nil
 	}
 
-	return createToken(*pHash, app.lines), nil
+	return createToken(*pHash, app.lines, app.name), nil
 }
@@ -68,3 +68,17 @@ func (obj *content) IsTree() bool {
 func (obj *content) Tree() Tree {
 	return obj.tree
 }
+
+// HasPosition returns true if there is a position, false otherwise
+func (obj *content) HasPosition() bool {
+	return obj.Position() != nil
+}
+
+// Position returns the position, if any
+func (obj *content) Position() Position {
+	if obj.IsValue() {
+		return obj.value.Position()
+	}
+
+	return obj.tree.Position()
+}
@@ -141,3 +141,23 @@ func (obj *element) HasGrammar() bool {
 func (obj *element) Amount() uint {
 	return uint(len(obj.contents))
 }
+
+// HasPosition returns true if there is a position, false otherwise
+func (obj *element) HasPosition() bool {
+	return obj.Position() != nil
+}
+
+// Position returns the position spanning the contents, if any
+func (obj *element) Position() Position {
+	if len(obj.contents) <= 0 {
+		return nil
+	}
+
+	first := obj.contents[0].Position()
+	last := obj.contents[len(obj.contents)-1].Position()
+	if first == nil || last == nil {
+		return nil
+	}
+
+	return createPosition(first.Start(), last.End())
+}
@@ -135,3 +135,23 @@ func (obj *line) IsSuccessful() bool {
 
 	return true
 }
+
+// HasPosition returns true if there is a position, false otherwise
+func (obj *line) HasPosition() bool {
+	return obj.Position() != nil
+}
+
+// Position returns the position spanning the elements, if any
+func (obj *line) Position() Position {
+	if len(obj.elements) <= 0 {
+		return nil
+	}
+
+	first := obj.elements[0].Position()
+	last := obj.elements[len(obj.elements)-1].Position()
+	if first == nil || last == nil {
+		return nil
+	}
+
+	return createPosition(first.Start(), last.End())
+}
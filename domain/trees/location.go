@@ -0,0 +1,36 @@
+package trees
+
+type location struct {
+	offset uint
+	line   uint
+	column uint
+}
+
+func createLocation(
+	offset uint,
+	line uint,
+	column uint,
+) Location {
+	out := location{
+		offset: offset,
+		line:   line,
+		column: column,
+	}
+
+	return &out
+}
+
+// Offset returns the byte offset
+func (obj *location) Offset() uint {
+	return obj.offset
+}
+
+// Line returns the line, starting at 1
+func (obj *location) Line() uint {
+	return obj.line
+}
+
+// Column returns the column, starting at 1
+func (obj *location) Column() uint {
+	return obj.column
+}
@@ -0,0 +1,28 @@
+package trees
+
+type position struct {
+	start Location
+	end   Location
+}
+
+func createPosition(
+	start Location,
+	end Location,
+) Position {
+	out := position{
+		start: start,
+		end:   end,
+	}
+
+	return &out
+}
+
+// Start returns the start location
+func (obj *position) Start() Location {
+	return obj.start
+}
+
+// End returns the end location
+func (obj *position) End() Location {
+	return obj.end
+}
@@ -0,0 +1,123 @@
+package trees
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+type positionBuilder struct {
+	input    []byte
+	pStart   *uint
+	pEnd     *uint
+	origin   Location
+	newLines []uint
+}
+
+func createPositionBuilder() PositionBuilder {
+	out := positionBuilder{
+		input:    nil,
+		pStart:   nil,
+		pEnd:     nil,
+		origin:   nil,
+		newLines: nil,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *positionBuilder) Create() PositionBuilder {
+	return createPositionBuilder()
+}
+
+// WithInput adds an input to the builder
+func (app *positionBuilder) WithInput(input []byte) PositionBuilder {
+	app.input = input
+	return app
+}
+
+// WithStart adds a start offset to the builder
+func (app *positionBuilder) WithStart(start uint) PositionBuilder {
+	app.pStart = &start
+	return app
+}
+
+// WithEnd adds an end offset to the builder
+func (app *positionBuilder) WithEnd(end uint) PositionBuilder {
+	app.pEnd = &end
+	return app
+}
+
+// WithOrigin adds the location of the start of the input to the builder
+func (app *positionBuilder) WithOrigin(origin Location) PositionBuilder {
+	app.origin = origin
+	return app
+}
+
+// WithNewLines adds the offsets of the new lines of the input to the builder, so that they are not searched again
+func (app *positionBuilder) WithNewLines(newLines []uint) PositionBuilder {
+	app.newLines = newLines
+	return app
+}
+
+// Now builds a new Position instance
+func (app *positionBuilder) Now() (Position, error) {
+	if app.input == nil {
+		return nil, errors.New("the input is mandatory in order to build a Position instance")
+	}
+
+	if app.pStart == nil {
+		return nil, errors.New("the start offset is mandatory in order to build a Position instance")
+	}
+
+	if app.pEnd == nil {
+		return nil, errors.New("the end offset is mandatory in order to build a Position instance")
+	}
+
+	start := *app.pStart
+	end := *app.pEnd
+	if start > end {
+		str := fmt.Sprintf("the start offset (%d) must be smaller or equal to the end offset (%d)", start, end)
+		return nil, errors.New(str)
+	}
+
+	length := uint(len(app.input))
+	if end > length {
+		str := fmt.Sprintf("the end offset (%d) must be smaller or equal to the length of the input (%d)", end, length)
+		return nil, errors.New(str)
+	}
+
+	newLines := app.newLines
+	if newLines == nil {
+		newLines = NewLines(app.input)
+	}
+
+	return createPosition(
+		app.location(newLines, start),
+		app.location(newLines, end),
+	), nil
+}
+
+func (app *positionBuilder) location(newLines []uint, offset uint) Location {
+	amount := sort.Search(len(newLines), func(idx int) bool {
+		return newLines[idx] >= offset
+	})
+
+	line := uint(amount) + 1
+	column := offset + 1
+	if amount > 0 {
+		column = offset - newLines[amount-1]
+	}
+
+	if app.origin == nil {
+		return createLocation(offset, line, column)
+	}
+
+	// the columns of the first line of the input continue the line of its origin:
+	if amount <= 0 {
+		column = app.origin.Column() + offset
+	}
+
+	return createLocation(app.origin.Offset()+offset, app.origin.Line()+line-1, column)
+}
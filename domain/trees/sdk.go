@@ -46,6 +46,25 @@ func NewValueBuilder() ValueBuilder {
 	return createValueBuilder(hashAdapter)
 }
 
+// NewPositionBuilder creates a new position builder
+func NewPositionBuilder() PositionBuilder {
+	return createPositionBuilder()
+}
+
+// NewLines returns the offsets of the new lines of an input
+func NewLines(input []byte) []uint {
+	output := []uint{}
+	for idx, oneByte := range input {
+		if oneByte != '\n' {
+			continue
+		}
+
+		output = append(output, uint(idx))
+	}
+
+	return output
+}
+
 // Builder represents a trees builder
 type Builder interface {
 	Create() Builder
@@ -65,6 +84,7 @@ type TreeBuilder interface {
 	Create() TreeBuilder
 	WithGrammar(grammar grammars.Token) TreeBuilder
 	WithToken(token Token) TreeBuilder
+	WithPrefix(prefix Trees) TreeBuilder
 	WithSuffix(suffix Trees) TreeBuilder
 	WithRemaining(remaining []byte) TreeBuilder
 	Now() (Tree, error)
@@ -77,16 +97,21 @@ type Tree interface {
 	Hash() hash.Hash
 	Grammar() grammars.Token
 	Token() Token
+	HasPrefix() bool
+	Prefix() Trees
 	HasSuffix() bool
 	Suffix() Trees
 	HasRemaining() bool
 	Remaining() []byte
+	HasPosition() bool
+	Position() Position
 }
 
 // TokenBuilder represents a token builder
 type TokenBuilder interface {
 	Create() TokenBuilder
 	WithLines(lines []Line) TokenBuilder
+	WithAlternatives(alternatives []Line) TokenBuilder
 	Now() (Token, error)
 }
 
@@ -96,6 +121,8 @@ type Token interface {
 	Lines() []Line
 	HasSuccessful() bool
 	Successful() Line
+	HasAlternatives() bool
+	Alternatives() []Line
 }
 
 // LineBuilder represents a line builder
@@ -118,6 +145,8 @@ type Line interface {
 	IsReverse() bool
 	HasElements() bool
 	Elements() []Element
+	HasPosition() bool
+	Position() Position
 }
 
 // ElementBuilder represents an element builder
@@ -138,6 +167,8 @@ type Element interface {
 	Amount() uint
 	HasGrammar() bool
 	Grammar() grammars.Element
+	HasPosition() bool
+	Position() Position
 }
 
 // ContentBuilder represents a content builder
@@ -156,6 +187,8 @@ type Content interface {
 	Value() Value
 	IsTree() bool
 	Tree() Tree
+	HasPosition() bool
+	Position() Position
 }
 
 // ValueBuilder represents a value builder
@@ -163,6 +196,7 @@ type ValueBuilder interface {
 	Create() ValueBuilder
 	WithContent(content []byte) ValueBuilder
 	WithPrefix(prefix Trees) ValueBuilder
+	WithPosition(position Position) ValueBuilder
 	Now() (Value, error)
 }
 
@@ -172,4 +206,30 @@ type Value interface {
 	Content() []byte
 	HasPrefix() bool
 	Prefix() Trees
+	HasPosition() bool
+	Position() Position
+}
+
+// PositionBuilder represents a position builder
+type PositionBuilder interface {
+	Create() PositionBuilder
+	WithInput(input []byte) PositionBuilder
+	WithStart(start uint) PositionBuilder
+	WithEnd(end uint) PositionBuilder
+	WithOrigin(origin Location) PositionBuilder
+	WithNewLines(newLines []uint) PositionBuilder
+	Now() (Position, error)
+}
+
+// Position represents the position of a node in its input
+type Position interface {
+	Start() Location
+	End() Location
+}
+
+// Location represents a location in an input
+type Location interface {
+	Offset() uint
+	Line() uint
+	Column() uint
 }
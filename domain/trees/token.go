@@ -3,16 +3,17 @@ package trees
 import "github.com/steve-care-software/libs/cryptography/hash"
 
 type token struct {
-	hash       hash.Hash
-	lines      []Line
-	successful Line
+	hash         hash.Hash
+	lines        []Line
+	successful   Line
+	alternatives []Line
 }
 
 func createToken(
 	hash hash.Hash,
 	lines []Line,
 ) Token {
-	return createTokenInternally(hash, lines, nil)
+	return createTokenInternally(hash, lines, nil, nil)
 }
 
 func createTokenWithSuccessful(
@@ -20,18 +21,29 @@ func createTokenWithSuccessful(
 	lines []Line,
 	successful Line,
 ) Token {
-	return createTokenInternally(hash, lines, successful)
+	return createTokenInternally(hash, lines, successful, nil)
+}
+
+func createTokenWithSuccessfulAndAlternatives(
+	hash hash.Hash,
+	lines []Line,
+	successful Line,
+	alternatives []Line,
+) Token {
+	return createTokenInternally(hash, lines, successful, alternatives)
 }
 
 func createTokenInternally(
 	hash hash.Hash,
 	lines []Line,
 	successful Line,
+	alternatives []Line,
 ) Token {
 	out := token{
-		hash:       hash,
-		lines:      lines,
-		successful: successful,
+		hash:         hash,
+		lines:        lines,
+		successful:   successful,
+		alternatives: alternatives,
 	}
 
 	return &out
@@ -56,3 +68,13 @@ func (obj *token) HasSuccessful() bool {
 func (obj *token) Successful() Line {
 	return obj.successful
 }
+
+// HasAlternatives returns true if there is alternatives, false otherwise
+func (obj *token) HasAlternatives() bool {
+	return obj.alternatives != nil
+}
+
+// Alternatives returns the other successful lines, if any
+func (obj *token) Alternatives() []Line {
+	return obj.alternatives
+}
@@ -2,21 +2,24 @@ package trees
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/steve-care-software/libs/cryptography/hash"
 )
 
 type tokenBuilder struct {
-	hashAdapter hash.Adapter
-	lines       []Line
+	hashAdapter  hash.Adapter
+	lines        []Line
+	alternatives []Line
 }
 
 func createTokenBuilder(
 	hashAdapter hash.Adapter,
 ) TokenBuilder {
 	out := tokenBuilder{
-		hashAdapter: hashAdapter,
-		lines:       nil,
+		hashAdapter:  hashAdapter,
+		lines:        nil,
+		alternatives: nil,
 	}
 
 	return &out
@@ -35,6 +38,12 @@ func (app *tokenBuilder) WithLines(lines []Line) TokenBuilder {
 	return app
 }
 
+// WithAlternatives add alternatives to the builder
+func (app *tokenBuilder) WithAlternatives(alternatives []Line) TokenBuilder {
+	app.alternatives = alternatives
+	return app
+}
+
 // Now builds a new Token instance
 func (app *tokenBuilder) Now() (Token, error) {
 	if app.lines != nil && len(app.lines) <= 0 {
@@ -63,6 +72,25 @@ func (app *tokenBuilder) Now() (Token, error) {
 		}
 	}
 
+	if app.alternatives != nil && len(app.alternatives) <= 0 {
+		app.alternatives = nil
+	}
+
+	if app.alternatives != nil {
+		if successful == nil {
+			return nil, errors.New("the alternatives cannot be set on a Token instance that has no successful Line")
+		}
+
+		for idx, oneAlternative := range app.alternatives {
+			if !oneAlternative.IsSuccessful() {
+				str := fmt.Sprintf("the alternative (index: %d) was expected to be a successful Line", idx)
+				return nil, errors.New(str)
+			}
+		}
+
+		return createTokenWithSuccessfulAndAlternatives(*pHash, app.lines, successful, app.alternatives), nil
+	}
+
 	if successful != nil {
 		return createTokenWithSuccessful(*pHash, app.lines, successful), nil
 	}
@@ -12,6 +12,7 @@ type tree struct {
 	hash      hash.Hash
 	grammar   grammars.Token
 	token     Token
+	prefix    Trees
 	suffix    Trees
 	remaining []byte
 }
@@ -20,42 +21,47 @@ func createTree(
 	hash hash.Hash,
 	grammar grammars.Token,
 	token Token,
+	prefix Trees,
 ) Tree {
-	return createTreeInternally(hash, grammar, token, nil, nil)
+	return createTreeInternally(hash, grammar, token, prefix, nil, nil)
 }
 
 func createTreeWithRemaining(
 	hash hash.Hash,
 	grammar grammars.Token,
 	token Token,
+	prefix Trees,
 	remaining []byte,
 ) Tree {
-	return createTreeInternally(hash, grammar, token, nil, remaining)
+	return createTreeInternally(hash, grammar, token, prefix, nil, remaining)
 }
 
 func createTreeWithSuffix(
 	hash hash.Hash,
 	grammar grammars.Token,
 	token Token,
+	prefix Trees,
 	suffix Trees,
 ) Tree {
-	return createTreeInternally(hash, grammar, token, suffix, nil)
+	return createTreeInternally(hash, grammar, token, prefix, suffix, nil)
 }
 
 func createTreeWithSuffixAndRemaining(
 	hash hash.Hash,
 	grammar grammars.Token,
 	token Token,
+	prefix Trees,
 	suffix Trees,
 	remaining []byte,
 ) Tree {
-	return createTreeInternally(hash, grammar, token, suffix, remaining)
+	return createTreeInternally(hash, grammar, token, prefix, suffix, remaining)
 }
 
 func createTreeInternally(
 	hash hash.Hash,
 	grammar grammars.Token,
 	token Token,
+	prefix Trees,
 	suffix Trees,
 	remaining []byte,
 ) Tree {
@@ -63,6 +69,7 @@ func createTreeInternally(
 		hash:      hash,
 		grammar:   grammar,
 		token:     token,
+		prefix:    prefix,
 		suffix:    suffix,
 		remaining: remaining,
 	}
@@ -113,6 +120,10 @@ func (obj *tree) Bytes(includeChannels bool) []byte {
 		return output
 	}
 
+	if includeChannels && obj.HasPrefix() {
+		output = append(output, obj.Prefix().Bytes(includeChannels)...)
+	}
+
 	elements := obj.token.Successful().Elements()
 	for _, oneElement := range elements {
 		output = append(output, oneElement.Bytes(includeChannels)...)
@@ -140,6 +151,16 @@ func (obj *tree) Token() Token {
 	return obj.token
 }
 
+// HasPrefix returns true if there is prefix, false otherwise
+func (obj *tree) HasPrefix() bool {
+	return obj.prefix != nil
+}
+
+// Prefix returns the prefix, if any
+func (obj *tree) Prefix() Trees {
+	return obj.prefix
+}
+
 // HasSuffix returns true if there is suffix, false otherwise
 func (obj *tree) HasSuffix() bool {
 	return obj.suffix != nil
@@ -159,3 +180,17 @@ func (obj *tree) HasRemaining() bool {
 func (obj *tree) Remaining() []byte {
 	return obj.remaining
 }
+
+// HasPosition returns true if there is a position, false otherwise
+func (obj *tree) HasPosition() bool {
+	return obj.Position() != nil
+}
+
+// Position returns the position of the successful line, if any
+func (obj *tree) Position() Position {
+	if !obj.token.HasSuccessful() {
+		return nil
+	}
+
+	return obj.token.Successful().Position()
+}
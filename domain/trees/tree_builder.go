@@ -11,6 +11,7 @@ type treeBuilder struct {
 	hashAdapter hash.Adapter
 	grammar     grammars.Token
 	token       Token
+	prefix      Trees
 	suffix      Trees
 	remaining   []byte
 }
@@ -22,6 +23,7 @@ func createTreeBuilder(
 		hashAdapter: hashAdapter,
 		grammar:     nil,
 		token:       nil,
+		prefix:      nil,
 		suffix:      nil,
 		remaining:   nil,
 	}
@@ -48,6 +50,12 @@ func (app *treeBuilder) WithToken(token Token) TreeBuilder {
 	return app
 }
 
+// WithPrefix adds a prefix to the builder
+func (app *treeBuilder) WithPrefix(prefix Trees) TreeBuilder {
+	app.prefix = prefix
+	return app
+}
+
 // WithSuffix adds a suffix to the builder
 func (app *treeBuilder) WithSuffix(suffix Trees) TreeBuilder {
 	app.suffix = suffix
@@ -79,6 +87,10 @@ func (app *treeBuilder) Now() (Tree, error) {
 		app.token.Hash().Bytes(),
 	}
 
+	if app.prefix != nil {
+		data = append(data, app.prefix.Hash().Bytes())
+	}
+
 	if app.suffix != nil {
 		data = append(data, app.suffix.Hash().Bytes())
 	}
@@ -93,16 +105,16 @@ func (app *treeBuilder) Now() (Tree, error) {
 	}
 
 	if app.remaining != nil && app.suffix != nil {
-		return createTreeWithSuffixAndRemaining(*pHash, app.grammar, app.token, app.suffix, app.remaining), nil
+		return createTreeWithSuffixAndRemaining(*pHash, app.grammar, app.token, app.prefix, app.suffix, app.remaining), nil
 	}
 
 	if app.remaining != nil {
-		return createTreeWithRemaining(*pHash, app.grammar, app.token, app.remaining), nil
+		return createTreeWithRemaining(*pHash, app.grammar, app.token, app.prefix, app.remaining), nil
 	}
 
 	if app.suffix != nil {
-		return createTreeWithSuffix(*pHash, app.grammar, app.token, app.suffix), nil
+		return createTreeWithSuffix(*pHash, app.grammar, app.token, app.prefix, app.suffix), nil
 	}
 
-	return createTree(*pHash, app.grammar, app.token), nil
+	return createTree(*pHash, app.grammar, app.token, app.prefix), nil
 }
@@ -3,16 +3,17 @@ package trees
 import "github.com/steve-care-software/libs/cryptography/hash"
 
 type value struct {
-	hash    hash.Hash
-	content []byte
-	prefix  Trees
+	hash     hash.Hash
+	content  []byte
+	prefix   Trees
+	position Position
 }
 
 func createValue(
 	hash hash.Hash,
 	content []byte,
 ) Value {
-	return createValueInternally(hash, content, nil)
+	return createValueInternally(hash, content, nil, nil)
 }
 
 func createValueWithPrefix(
@@ -20,18 +21,37 @@ func createValueWithPrefix(
 	content []byte,
 	prefix Trees,
 ) Value {
-	return createValueInternally(hash, content, prefix)
+	return createValueInternally(hash, content, prefix, nil)
+}
+
+func createValueWithPosition(
+	hash hash.Hash,
+	content []byte,
+	position Position,
+) Value {
+	return createValueInternally(hash, content, nil, position)
+}
+
+func createValueWithPrefixAndPosition(
+	hash hash.Hash,
+	content []byte,
+	prefix Trees,
+	position Position,
+) Value {
+	return createValueInternally(hash, content, prefix, position)
 }
 
 func createValueInternally(
 	hash hash.Hash,
 	content []byte,
 	prefix Trees,
+	position Position,
 ) Value {
 	out := value{
-		hash:    hash,
-		content: content,
-		prefix:  prefix,
+		hash:     hash,
+		content:  content,
+		prefix:   prefix,
+		position: position,
 	}
 
 	return &out
@@ -56,3 +76,13 @@ func (obj *value) HasPrefix() bool {
 func (obj *value) Prefix() Trees {
 	return obj.prefix
 }
+
+// HasPosition returns true if there is a position, false otherwise
+func (obj *value) HasPosition() bool {
+	return obj.position != nil
+}
+
+// Position returns the position, if any
+func (obj *value) Position() Position {
+	return obj.position
+}
@@ -10,6 +10,7 @@ type valueBuilder struct {
 	hashAdapter hash.Adapter
 	content     []byte
 	prefix      Trees
+	position    Position
 }
 
 func createValueBuilder(
@@ -19,6 +20,7 @@ func createValueBuilder(
 		hashAdapter: hashAdapter,
 		content:     nil,
 		prefix:      nil,
+		position:    nil,
 	}
 
 	return &out
@@ -43,6 +45,12 @@ func (app *valueBuilder) WithPrefix(prefix Trees) ValueBuilder {
 	return app
 }
 
+// WithPosition adds a position to the builder
+func (app *valueBuilder) WithPosition(position Position) ValueBuilder {
+	app.position = position
+	return app
+}
+
 // Now builds a new Value instance
 func (app *valueBuilder) Now() (Value, error) {
 	if app.content != nil && len(app.content) <= 0 {
@@ -66,9 +74,17 @@ func (app *valueBuilder) Now() (Value, error) {
 		return nil, err
 	}
 
+	if app.prefix != nil && app.position != nil {
+		return createValueWithPrefixAndPosition(*pHash, app.content, app.prefix, app.position), nil
+	}
+
 	if app.prefix != nil {
 		return createValueWithPrefix(*pHash, app.content, app.prefix), nil
 	}
 
+	if app.position != nil {
+		return createValueWithPosition(*pHash, app.content, app.position), nil
+	}
+
 	return createValue(*pHash, app.content), nil
 }
@@ -0,0 +1,208 @@
+package exporters
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/steve-care-software/grammars/applications/diffs"
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/references"
+)
+
+const validSuiteLabel = "valid"
+const invalidSuiteLabel = "invalid"
+
+var changelogKinds = map[uint8]string{
+	diffs.KindAdded:   "added",
+	diffs.KindRemoved: "removed",
+	diffs.KindChanged: "changed",
+}
+
+type changelogExporter struct {
+}
+
+func createChangelog() DiffExporter {
+	out := changelogExporter{}
+	return &out
+}
+
+// Export exports the diff to a markdown changelog
+func (app *changelogExporter) Export(diff diffs.Diff) ([]byte, error) {
+	output := bytes.NewBuffer(nil)
+	output.WriteString("# Changelog\n")
+	if diff.IsEmpty() {
+		output.WriteString("\nNo structural change.\n")
+		return output.Bytes(), nil
+	}
+
+	from := diff.From()
+	to := diff.To()
+	if diff.IsRootChanged() {
+		output.WriteString("\n## Root\n")
+		output.WriteString(fmt.Sprintf("- changed `%s` to `%s`\n", from.Tokens().Name(from.Root().Root()), to.Tokens().Name(to.Root().Root())))
+	}
+
+	tokens := diff.Tokens()
+	if len(tokens) > 0 {
+		output.WriteString("\n## Tokens\n")
+	}
+
+	for _, oneToken := range tokens {
+		output.WriteString(fmt.Sprintf("- %s `%s`\n", changelogKinds[oneToken.Kind()], oneToken.Name()))
+		for _, oneLine := range oneToken.Lines() {
+			app.line(output, from, to, oneLine)
+		}
+
+		for _, oneSuite := range oneToken.Suites() {
+			suite := oneSuite.Suite()
+			validity := invalidSuiteLabel
+			if suite.IsValid() {
+				validity = validSuiteLabel
+			}
+
+			output.WriteString(fmt.Sprintf("  - %s %s suite: `%s`\n", changelogKinds[oneSuite.Kind()], validity, valueLabel(suite.Content())))
+		}
+	}
+
+	channels := diff.Channels()
+	if len(channels) > 0 {
+		output.WriteString("\n## Channels\n")
+	}
+
+	for _, oneChannel := range channels {
+		output.WriteString(fmt.Sprintf("- %s `%s`", changelogKinds[oneChannel.Kind()], oneChannel.Name()))
+		if oneChannel.Kind() == diffs.KindChanged {
+			output.WriteString(fmt.Sprintf(" condition: `%s` to `%s`", app.condition(from, oneChannel.From()), app.condition(to, oneChannel.To())))
+		}
+
+		output.WriteString("\n")
+	}
+
+	return output.Bytes(), nil
+}
+
+func (app *changelogExporter) line(output *bytes.Buffer, from references.Reference, to references.Reference, line diffs.Line) {
+	kind := changelogKinds[line.Kind()]
+	if line.Kind() == diffs.KindRemoved {
+		output.WriteString(fmt.Sprintf("  - %s line %d: `%s`\n", kind, line.Index(), app.elements(from, line.From().Elements())))
+		return
+	}
+
+	if line.Kind() == diffs.KindAdded {
+		output.WriteString(fmt.Sprintf("  - %s line %d: `%s`\n", kind, line.Index(), app.elements(to, line.To().Elements())))
+		return
+	}
+
+	output.WriteString(fmt.Sprintf("  - %s line %d: `%s` to `%s`\n", kind, line.Index(), app.elements(from, line.From().Elements()), app.elements(to, line.To().Elements())))
+	for _, oneElement := range line.Elements() {
+		kind := changelogKinds[oneElement.Kind()]
+		if oneElement.Kind() == diffs.KindRemoved {
+			output.WriteString(fmt.Sprintf("    - %s element %d: `%s`\n", kind, oneElement.Index(), app.element(from, oneElement.From())))
+			continue
+		}
+
+		if oneElement.Kind() == diffs.KindAdded {
+			output.WriteString(fmt.Sprintf("    - %s element %d: `%s`\n", kind, oneElement.Index(), app.element(to, oneElement.To())))
+			continue
+		}
+
+		if oneElement.IsContentChanged() {
+			output.WriteString(fmt.Sprintf("    - %s element %d content: `%s` to `%s`\n", kind, oneElement.Index(), app.content(from, oneElement.From().Content()), app.content(to, oneElement.To().Content())))
+		}
+
+		if oneElement.IsCardinalityChanged() {
+			output.WriteString(fmt.Sprintf("    - %s element %d cardinality: `%s` to `%s`\n", kind, oneElement.Index(), app.cardinality(oneElement.From().Cardinality()), app.cardinality(oneElement.To().Cardinality())))
+		}
+	}
+}
+
+func (app *changelogExporter) elements(reference references.Reference, elements []grammars.Element) string {
+	output := []string{}
+	for _, oneElement := range elements {
+		output = append(output, app.element(reference, oneElement))
+	}
+
+	return strings.Join(output, " ")
+}
+
+func (app *changelogExporter) element(reference references.Reference, element grammars.Element) string {
+	return fmt.Sprintf("%s%s", app.content(reference, element.Content()), app.cardinality(element.Cardinality()))
+}
+
+func (app *changelogExporter) content(reference references.Reference, content grammars.ElementContent) string {
+	if content.IsValue() {
+		return valueLabel(content.Value())
+	}
+
+	if content.IsGrammar() {
+		return fmt.Sprintf("{%s}", reference.Tokens().Name(content.Grammar().Root()))
+	}
+
+	if content.IsRecursive() {
+		return content.Recursive()
+	}
+
+	instance := content.Instance()
+	if instance.IsToken() {
+		return reference.Tokens().Name(instance.Token())
+	}
+
+	everything := instance.Everything()
+	output := fmt.Sprintf("#%s", reference.Tokens().Name(everything.Exception()))
+	if everything.HasEscape() {
+		output = fmt.Sprintf("%s!%s", output, reference.Tokens().Name(everything.Escape()))
+	}
+
+	return output
+}
+
+// cardinality returns the cardinality using the notation of the scripts
+func (app *changelogExporter) cardinality(cardinality grammars.Cardinality) string {
+	min := cardinality.Min()
+	if !cardinality.HasMax() {
+		if min == 0 {
+			return "*"
+		}
+
+		if min == 1 {
+			return "+"
+		}
+
+		return fmt.Sprintf("[%d,]", min)
+	}
+
+	max := *cardinality.Max()
+	if min == 1 && max == 1 {
+		return ""
+	}
+
+	if min == 0 && max == 1 {
+		return "?"
+	}
+
+	if min == max {
+		return fmt.Sprintf("[%d]", min)
+	}
+
+	return fmt.Sprintf("[%d,%d]", min, max)
+}
+
+func (app *changelogExporter) condition(reference references.Reference, channel grammars.Channel) string {
+	if !channel.HasCondition() {
+		return ""
+	}
+
+	previous := ""
+	next := ""
+	condition := channel.Condition()
+	if condition.HasPrevious() {
+		previous = reference.Tokens().Name(condition.Previous())
+	}
+
+	if condition.HasNext() {
+		next = reference.Tokens().Name(condition.Next())
+	}
+
+	return fmt.Sprintf("[%s:%s]", previous, next)
+}
@@ -0,0 +1,83 @@
+package exporters
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/steve-care-software/grammars/applications/graphs"
+)
+
+type dotExporter struct {
+}
+
+func createDOT() GraphExporter {
+	out := dotExporter{}
+	return &out
+}
+
+// Export exports the graph to Graphviz DOT, where the cycles are red and the unreachable tokens are dashed
+func (app *dotExporter) Export(graph graphs.Graph) ([]byte, error) {
+	_, cycles, unreachable := graphIndexes(graph)
+	output := bytes.NewBuffer(nil)
+	output.WriteString(fmt.Sprintf("digraph %s {\n", app.quote(graph.Root().Name())))
+	output.WriteString("\tnode [shape=box];\n")
+	for _, oneNode := range graph.Nodes() {
+		attributes := []string{}
+		if oneNode == graph.Root() {
+			attributes = append(attributes, "style=bold")
+		}
+
+		if unreachable[oneNode] {
+			attributes = append(attributes, "style=dashed")
+		}
+
+		if _, ok := cycles[oneNode]; ok {
+			attributes = append(attributes, "color=red")
+		}
+
+		output.WriteString(fmt.Sprintf("\t%s%s;\n", app.quote(oneNode.Name()), app.attributes(attributes)))
+	}
+
+	for _, oneEdge := range graph.Edges() {
+		attributes := []string{}
+		if label := edgeLabels[oneEdge.Kind()]; label != "" {
+			attributes = append(attributes, fmt.Sprintf("label=%s", app.quote(label)))
+		}
+
+		if oneEdge.Kind() == graphs.KindChannel || oneEdge.Kind() == graphs.KindCondition {
+			attributes = append(attributes, "style=dotted")
+		}
+
+		if app.isCycle(cycles, oneEdge) {
+			attributes = append(attributes, "color=red")
+		}
+
+		output.WriteString(fmt.Sprintf("\t%s -> %s%s;\n", app.quote(oneEdge.From().Name()), app.quote(oneEdge.To().Name()), app.attributes(attributes)))
+	}
+
+	output.WriteString("}\n")
+	return output.Bytes(), nil
+}
+
+func (app *dotExporter) isCycle(cycles map[graphs.Node]int, edge graphs.Edge) bool {
+	from, ok := cycles[edge.From()]
+	if !ok {
+		return false
+	}
+
+	to, ok := cycles[edge.To()]
+	return ok && from == to
+}
+
+func (app *dotExporter) attributes(attributes []string) string {
+	if len(attributes) <= 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(" [%s]", strings.Join(attributes, ", "))
+}
+
+func (app *dotExporter) quote(value string) string {
+	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(value, "\"", "\\\""))
+}
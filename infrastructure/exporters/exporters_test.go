@@ -0,0 +1,318 @@
+package exporters
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+
+	"github.com/steve-care-software/grammars/applications"
+	"github.com/steve-care-software/grammars/applications/diffs"
+	"github.com/steve-care-software/grammars/applications/graphs"
+	"github.com/steve-care-software/grammars/domain/references"
+	"github.com/steve-care-software/grammars/infrastructure/scripts"
+)
+
+func TestExporters_Success(t *testing.T) {
+	script := `@expression;
+
+expression: term plusTerm*
+	---
+	valid: onePlusTwo & two;
+	invalid: open & one;
+;
+
+plusTerm: plus term;
+term: number
+	| parenthesis
+;
+
+parenthesis: open expression close;
+number: one | two;
+one: 49;
+two: 50;
+plus: 43;
+open: 40;
+close: 41;
+onePlusTwo: one plus two;
+`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	coverages, err := applications.NewApplication().Coverages(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	jsonOutput, err := NewJSON().Export(coverages)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	report := jsonReport{}
+	err = json.Unmarshal(jsonOutput, &report)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(report.Coverages) != 1 || len(report.Coverages[0].Executions) != 4 || report.Coverages[0].Executions[3].IsPassed {
+		t.Errorf("the JSON report was expected to contain 4 executions, the last one failing: %s", jsonOutput)
+		return
+	}
+
+	junitOutput, err := NewJUnit().Export(coverages)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	suites := junitSuites{}
+	err = xml.Unmarshal(junitOutput, &suites)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if suites.Tests != 4 || suites.Failures != 1 || suites.Suites[0].Cases[3].Failure == nil {
+		t.Errorf("the JUnit report was expected to contain 4 tests and 1 failure: %s", junitOutput)
+		return
+	}
+
+	lcovOutput, err := NewLCOV().Export("expression.grammar", []byte(script), coverages)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := []string{
+		"SF:expression.grammar",
+		"BRDA:10,2,0,1",
+		"BRDA:11,2,1,0",
+		"DA:11,0",
+		"DA:14,0",
+		"end_of_record",
+	}
+
+	for _, oneLine := range expected {
+		if !strings.Contains(string(lcovOutput), oneLine+"\n") {
+			t.Errorf("the LCOV report was expected to contain the line (%s): \n%s", oneLine, lcovOutput)
+			return
+		}
+	}
+
+	htmlOutput, err := NewHTML().Export("expression.grammar", []byte(script), coverages)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !strings.Contains(string(htmlOutput), `<span class="uncovered"><i>11</i>	| parenthesis</span>`) {
+		t.Errorf("the HTML report was expected to highlight the dead line: \n%s", htmlOutput)
+		return
+	}
+}
+
+func TestGraphExporters_Success(t *testing.T) {
+	script := `
+		@expression;
+		-space;
+
+		expression: term plusTerm*;
+		plusTerm: plus term;
+		term: number | parenthesis;
+		parenthesis: open expression close;
+		number: one+;
+
+		one: 49;
+		plus: 43;
+		open: 40;
+		close: 41;
+		space: 32;
+	`
+
+	compiler := scripts.NewCompiler()
+	reference, err := compiler.Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	// the token of another grammar is added to the reference, without being reachable from its root:
+	other, err := compiler.Compile([]byte("@letters; letters: lowerA+; lowerA: 97;"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	tokens, err := references.NewTokensBuilder().Create().WithList(append(reference.Tokens().List(), other.Tokens().List()...)).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	reference, err = references.NewBuilder().Create().WithRoot(reference.Root()).WithTokens(tokens).Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	graph := graphs.NewApplication().Graph(reference)
+	cycles := graph.Cycles()
+	if len(cycles) != 1 || len(cycles[0]) != 4 {
+		t.Errorf("the graph was expected to contain 1 cycle of 4 tokens, returned: %v", cycles)
+		return
+	}
+
+	unreachable := graph.Unreachable()
+	if len(unreachable) != 1 || unreachable[0].Name() != "letters" {
+		t.Errorf("the letters token was expected to be the only unreachable token")
+		return
+	}
+
+	dotOutput, err := NewDOT().Export(graph)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := []string{
+		`digraph "expression" {`,
+		`	"letters" [style=dashed];`,
+		`	"term" -> "number";`,
+		`	"expression" -> "space" [label="channel", style=dotted];`,
+		`	"parenthesis" -> "expression" [label="recursive", color=red];`,
+	}
+
+	for _, oneLine := range expected {
+		if !strings.Contains(string(dotOutput), oneLine+"\n") {
+			t.Errorf("the DOT graph was expected to contain the line (%s): \n%s", oneLine, dotOutput)
+			return
+		}
+	}
+
+	mermaidOutput, err := NewMermaid().Export(graph)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected = []string{
+		"flowchart LR",
+		`	n0["expression"]`,
+		"	n0 -. channel .-> n5",
+		"	n3 -- recursive --> n0",
+		"	class n6 unreachable",
+	}
+
+	for _, oneLine := range expected {
+		if !strings.Contains(string(mermaidOutput), oneLine+"\n") {
+			t.Errorf("the Mermaid graph was expected to contain the line (%s): \n%s", oneLine, mermaidOutput)
+			return
+		}
+	}
+}
+
+func TestRailroad_Success(t *testing.T) {
+	script := `
+		@item;
+		item: string | number;
+		string: quote text quote;
+		number: minus? digit[1,3] digit*;
+		text: #quote!backslash;
+		digit: one | two;
+
+		quote: 34;
+		backslash: 92;
+		minus: 45;
+		one: 49;
+		two: 50;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := map[string][]string{
+		"item": {
+			`<title>item</title>`,
+			`<text x="94" y="31">string</text>`,
+			`<text x="94" y="63">number</text>`,
+		},
+		"string": {
+			`<rect class="everything"`,
+			`>anything except quote, escaped by backslash</text>`,
+		},
+		"number": {
+			`<path d="M40 31q10 0 10 10v1q0 10 10 10"/>`,
+			`<text x="82" y="52">&#34;-&#34;</text>`,
+			`<path d="M204 31q10 0 10 10v1q0 10 -10 10h-60q-10 0 -10 -10v-1q0 -10 10 -10"/>`,
+			`<text class="label" x="174" y="69">1 to 3 times</text>`,
+		},
+	}
+
+	exporter := NewRailroad()
+	for _, oneToken := range reference.Tokens().List() {
+		lines, ok := expected[oneToken.Name()]
+		if !ok {
+			continue
+		}
+
+		svg, err := exporter.Export(reference, oneToken.Reference())
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		for _, oneLine := range lines {
+			if !strings.Contains(string(svg), oneLine) {
+				t.Errorf("the diagram of the token (name: %s) was expected to contain (%s): \n%s", oneToken.Name(), oneLine, svg)
+				return
+			}
+		}
+	}
+}
+
+func TestChangelog_Success(t *testing.T) {
+	compiler := scripts.NewCompiler()
+	from, err := compiler.Compile([]byte("@number; -space; number: one+ plus?; one: 49; plus: 43; space: 32;"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	to, err := compiler.Compile([]byte("@number; number: one[1,3] plus? | minus one; one: 49; plus: 43; minus: 45;"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	output, err := NewChangelog().Export(diffs.NewApplication().Diff(from, to))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := "# Changelog\n" +
+		"\n## Tokens\n" +
+		"- changed `number`\n" +
+		"  - changed line 0: `\"1\"+ \"+\"?` to `\"1\"[1,3] \"+\"?`\n" +
+		"    - changed element 0 cardinality: `+` to `[1,3]`\n" +
+		"  - added line 1: `\"-\" \"1\"`\n" +
+		"- removed `space`\n" +
+		"\n## Channels\n" +
+		"- removed `space`\n"
+
+	if string(output) != expected {
+		t.Errorf("the changelog was expected to be: \n%s\nreturned: \n%s", expected, output)
+		return
+	}
+}
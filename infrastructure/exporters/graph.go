@@ -0,0 +1,37 @@
+package exporters
+
+import (
+	"github.com/steve-care-software/grammars/applications/graphs"
+)
+
+var edgeLabels = map[uint8]string{
+	graphs.KindInstance:  "",
+	graphs.KindException: "exception",
+	graphs.KindEscape:    "escape",
+	graphs.KindRecursive: "recursive",
+	graphs.KindExternal:  "external",
+	graphs.KindChannel:   "channel",
+	graphs.KindCondition: "condition",
+}
+
+// graphIndexes returns the index of each node, the cycle of the nodes that are part of one and the unreachable nodes
+func graphIndexes(graph graphs.Graph) (map[graphs.Node]int, map[graphs.Node]int, map[graphs.Node]bool) {
+	indexes := map[graphs.Node]int{}
+	for idx, oneNode := range graph.Nodes() {
+		indexes[oneNode] = idx
+	}
+
+	cycles := map[graphs.Node]int{}
+	for idx, oneCycle := range graph.Cycles() {
+		for _, oneNode := range oneCycle {
+			cycles[oneNode] = idx
+		}
+	}
+
+	unreachable := map[graphs.Node]bool{}
+	for _, oneNode := range graph.Unreachable() {
+		unreachable[oneNode] = true
+	}
+
+	return indexes, cycles, unreachable
+}
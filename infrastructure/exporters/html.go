@@ -0,0 +1,163 @@
+package exporters
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+
+	"github.com/steve-care-software/grammars/domain/references/coverages"
+)
+
+const htmlTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Name}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { border: 1px solid #ccc; padding: 0.2em 0.6em; text-align: left; }
+pre { border: 1px solid #ccc; padding: 0; }
+pre span { display: block; padding: 0 0.6em; }
+pre span i { display: inline-block; width: 3em; color: #999; font-style: normal; }
+.covered { background: #dfd; }
+.partial { background: #ffd; }
+.uncovered { background: #fdd; }
+</style>
+</head>
+<body>
+<h1>{{.Name}}</h1>
+<table>
+<tr><th>token</th><th>lines</th><th>elements</th></tr>
+{{range .Tokens}}<tr><td>{{.Name}}</td><td>{{.Lines}}</td><td>{{.Elements}}</td></tr>
+{{end}}</table>
+<table>
+<tr><th>token</th><th>suite</th><th>result</th></tr>
+{{range .Executions}}<tr class="{{.Class}}"><td>{{.Name}}</td><td>{{.Suite}}</td><td>{{.Result}}</td></tr>
+{{end}}</table>
+<pre>{{range .Lines}}<span class="{{.Class}}"><i>{{.Number}}</i>{{.Text}}</span>{{end}}</pre>
+</body>
+</html>
+`
+
+type htmlExporter struct {
+	source   *source
+	template *template.Template
+}
+
+type htmlReport struct {
+	Name       string
+	Tokens     []htmlToken
+	Executions []htmlExecution
+	Lines      []htmlLine
+}
+
+type htmlToken struct {
+	Name     string
+	Lines    string
+	Elements string
+}
+
+type htmlExecution struct {
+	Class  string
+	Name   string
+	Suite  string
+	Result string
+}
+
+type htmlLine struct {
+	Class  string
+	Number uint
+	Text   string
+}
+
+func createHTML(
+	source *source,
+) ScriptExporter {
+	out := htmlExporter{
+		source:   source,
+		template: template.Must(template.New("report").Parse(htmlTemplate)),
+	}
+
+	return &out
+}
+
+// Export exports the coverages of the script to a self-contained HTML report
+func (app *htmlExporter) Export(name string, script []byte, coverages coverages.Coverages) ([]byte, error) {
+	listing, err := app.source.listing(script, coverages)
+	if err != nil {
+		return nil, err
+	}
+
+	report := htmlReport{
+		Name:       name,
+		Tokens:     []htmlToken{},
+		Executions: []htmlExecution{},
+		Lines:      []htmlLine{},
+	}
+
+	for _, oneToken := range listing.tokens {
+		report.Tokens = append(report.Tokens, htmlToken{
+			Name:     oneToken.Reference().Name(),
+			Lines:    fmt.Sprintf("%.2f%%", oneToken.LinesPercentage()),
+			Elements: fmt.Sprintf("%.2f%%", oneToken.ElementsPercentage()),
+		})
+	}
+
+	for _, oneCoverage := range coverages.List() {
+		for _, oneExecution := range oneCoverage.Executions().List() {
+			expectation := oneExecution.Expectation()
+			execution := htmlExecution{
+				Class:  htmlCoveredClass,
+				Name:   oneCoverage.Token().Name(),
+				Suite:  fmt.Sprintf("invalid: %q", expectation.Content()),
+				Result: "rejected",
+			}
+
+			if expectation.IsValid() {
+				execution.Suite = fmt.Sprintf("valid: %q", expectation.Content())
+			}
+
+			result := oneExecution.Result()
+			if result.IsTree() {
+				execution.Result = "accepted"
+				if result.Tree().HasRemaining() {
+					execution.Result = fmt.Sprintf("remaining: %q", result.Tree().Remaining())
+				}
+			}
+
+			if !oneExecution.Passed() {
+				execution.Class = htmlUncoveredClass
+			}
+
+			report.Executions = append(report.Executions, execution)
+		}
+	}
+
+	covered, uncovered := listing.hits()
+	for idx, oneLine := range listing.lines {
+		number := uint(idx + 1)
+		line := htmlLine{
+			Number: number,
+			Text:   oneLine,
+		}
+
+		if covered[number] > 0 && uncovered[number] > 0 {
+			line.Class = htmlPartialClass
+		} else if covered[number] > 0 {
+			line.Class = htmlCoveredClass
+		} else if uncovered[number] > 0 {
+			line.Class = htmlUncoveredClass
+		}
+
+		report.Lines = append(report.Lines, line)
+	}
+
+	output := bytes.NewBuffer(nil)
+	err = app.template.Execute(output, report)
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Bytes(), nil
+}
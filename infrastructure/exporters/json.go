@@ -0,0 +1,112 @@
+package exporters
+
+import (
+	"encoding/json"
+
+	"github.com/steve-care-software/grammars/domain/references/coverages"
+)
+
+type jsonExporter struct {
+}
+
+type jsonReport struct {
+	Coverages []jsonCoverage `json:"coverages"`
+	Tokens    []jsonToken    `json:"tokens,omitempty"`
+}
+
+type jsonCoverage struct {
+	Token      string          `json:"token"`
+	Executions []jsonExecution `json:"executions"`
+}
+
+type jsonExecution struct {
+	IsValid  bool   `json:"valid"`
+	Content  string `json:"content"`
+	IsPassed bool   `json:"passed"`
+	Error    string `json:"error,omitempty"`
+}
+
+type jsonToken struct {
+	Token              string     `json:"token"`
+	LinesPercentage    float64    `json:"linesPercentage"`
+	ElementsPercentage float64    `json:"elementsPercentage"`
+	Lines              []jsonLine `json:"lines"`
+}
+
+type jsonLine struct {
+	Index      uint          `json:"index"`
+	IsCovered  bool          `json:"covered"`
+	Percentage float64       `json:"percentage"`
+	Elements   []jsonElement `json:"elements"`
+}
+
+type jsonElement struct {
+	Index     uint `json:"index"`
+	IsCovered bool `json:"covered"`
+}
+
+func createJSON() Exporter {
+	out := jsonExporter{}
+	return &out
+}
+
+// Export exports the coverages to JSON
+func (app *jsonExporter) Export(coverages coverages.Coverages) ([]byte, error) {
+	report := jsonReport{
+		Coverages: []jsonCoverage{},
+	}
+
+	for _, oneCoverage := range coverages.List() {
+		executions := []jsonExecution{}
+		for _, oneExecution := range oneCoverage.Executions().List() {
+			result := oneExecution.Result()
+			execution := jsonExecution{
+				IsValid:  oneExecution.Expectation().IsValid(),
+				Content:  string(oneExecution.Expectation().Content()),
+				IsPassed: oneExecution.Passed(),
+			}
+
+			if result.IsError() {
+				execution.Error = result.Error()
+			}
+
+			executions = append(executions, execution)
+		}
+
+		report.Coverages = append(report.Coverages, jsonCoverage{
+			Token:      oneCoverage.Token().Name(),
+			Executions: executions,
+		})
+	}
+
+	if coverages.HasTokens() {
+		for _, oneToken := range coverages.Tokens() {
+			lines := []jsonLine{}
+			for _, oneLine := range oneToken.Lines() {
+				elements := []jsonElement{}
+				for _, oneElement := range oneLine.Elements() {
+					elements = append(elements, jsonElement{
+						Index:     oneElement.Index(),
+						IsCovered: oneElement.IsCovered(),
+					})
+				}
+
+				lines = append(lines, jsonLine{
+					Index:      oneLine.Index(),
+					IsCovered:  oneLine.IsCovered(),
+					Percentage: oneLine.Percentage(),
+					Elements:   elements,
+				})
+			}
+
+			report.Tokens = append(report.Tokens, jsonToken{
+				Token:              oneToken.Reference().Name(),
+				LinesPercentage:    oneToken.LinesPercentage(),
+				ElementsPercentage: oneToken.ElementsPercentage(),
+				Lines:              lines,
+			})
+		}
+	}
+
+	return json.MarshalIndent(report, "", "\t")
+}
@@ -0,0 +1,110 @@
+package exporters
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/steve-care-software/grammars/domain/references/coverages"
+)
+
+type junitExporter struct {
+}
+
+type junitSuites struct {
+	XMLName  xml.Name     `xml:"testsuites"`
+	Tests    uint         `xml:"tests,attr"`
+	Failures uint         `xml:"failures,attr"`
+	Suites   []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    uint        `xml:"tests,attr"`
+	Failures uint        `xml:"failures,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Content string `xml:",chardata"`
+}
+
+func createJUnit() Exporter {
+	out := junitExporter{}
+	return &out
+}
+
+// Export exports the coverages to JUnit XML, using a test suite per token and a test case per execution
+func (app *junitExporter) Export(coverages coverages.Coverages) ([]byte, error) {
+	report := junitSuites{
+		Suites: []junitSuite{},
+	}
+
+	for _, oneCoverage := range coverages.List() {
+		name := oneCoverage.Token().Name()
+		suite := junitSuite{
+			Name:  name,
+			Cases: []junitCase{},
+		}
+
+		for idx, oneExecution := range oneCoverage.Executions().List() {
+			expectation := oneExecution.Expectation()
+			kind := "invalid"
+			if expectation.IsValid() {
+				kind = "valid"
+			}
+
+			testCase := junitCase{
+				Name:      fmt.Sprintf("%s (index: %d): %q", kind, idx, expectation.Content()),
+				ClassName: name,
+			}
+
+			if !oneExecution.Passed() {
+				testCase.Failure = app.failure(oneExecution)
+				suite.Failures++
+			}
+
+			suite.Cases = append(suite.Cases, testCase)
+			suite.Tests++
+		}
+
+		report.Suites = append(report.Suites, suite)
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+	}
+
+	output, err := xml.MarshalIndent(report, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), output...), nil
+}
+
+func (app *junitExporter) failure(execution coverages.Execution) *junitFailure {
+	result := execution.Result()
+	if execution.Expectation().IsValid() && result.IsTree() {
+		return &junitFailure{
+			Message: "the suite was expected to be valid, but contains remaining data",
+			Content: string(result.Tree().Remaining()),
+		}
+	}
+
+	if execution.Expectation().IsValid() {
+		return &junitFailure{
+			Message: "the suite was expected to be valid",
+			Content: result.Error(),
+		}
+	}
+
+	return &junitFailure{
+		Message: "the suite was expected to be invalid",
+		Content: string(result.Tree().Bytes(true)),
+	}
+}
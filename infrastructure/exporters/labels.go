@@ -0,0 +1,17 @@
+package exporters
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// valueLabel returns the printable representation of a value, or its bytes when it is not printable
+func valueLabel(value []byte) string {
+	for _, oneByte := range value {
+		if oneByte < 32 || oneByte > 126 {
+			return fmt.Sprintf("%v", value)
+		}
+	}
+
+	return strconv.Quote(string(value))
+}
@@ -0,0 +1,78 @@
+package exporters
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/steve-care-software/grammars/domain/references/coverages"
+)
+
+type lcovExporter struct {
+	source *source
+}
+
+func createLCOV(
+	source *source,
+) ScriptExporter {
+	out := lcovExporter{
+		source: source,
+	}
+
+	return &out
+}
+
+// Export exports the coverages of the script to LCOV, using a branch per token line
+func (app *lcovExporter) Export(name string, script []byte, coverages coverages.Coverages) ([]byte, error) {
+	listing, err := app.source.listing(script, coverages)
+	if err != nil {
+		return nil, err
+	}
+
+	output := bytes.NewBuffer(nil)
+	output.WriteString("TN:\n")
+	output.WriteString(fmt.Sprintf("SF:%s\n", name))
+
+	blocks := map[string]uint{}
+	branchesHit := 0
+	for _, oneLocation := range listing.locations {
+		if _, ok := blocks[oneLocation.token]; !ok {
+			blocks[oneLocation.token] = uint(len(blocks))
+		}
+
+		taken := 0
+		if oneLocation.isCovered {
+			taken = 1
+			branchesHit++
+		}
+
+		output.WriteString(fmt.Sprintf("BRDA:%d,%d,%d,%d\n", oneLocation.start, blocks[oneLocation.token], oneLocation.index, taken))
+	}
+
+	output.WriteString(fmt.Sprintf("BRF:%d\n", len(listing.locations)))
+	output.WriteString(fmt.Sprintf("BRH:%d\n", branchesHit))
+
+	covered, uncovered := listing.hits()
+	lines := []int{}
+	for oneLine := range covered {
+		lines = append(lines, int(oneLine))
+	}
+
+	for oneLine := range uncovered {
+		if _, ok := covered[oneLine]; ok {
+			continue
+		}
+
+		lines = append(lines, int(oneLine))
+	}
+
+	sort.Ints(lines)
+	for _, oneLine := range lines {
+		output.WriteString(fmt.Sprintf("DA:%d,%d\n", oneLine, covered[uint(oneLine)]))
+	}
+
+	output.WriteString(fmt.Sprintf("LF:%d\n", len(lines)))
+	output.WriteString(fmt.Sprintf("LH:%d\n", len(covered)))
+	output.WriteString("end_of_record\n")
+	return output.Bytes(), nil
+}
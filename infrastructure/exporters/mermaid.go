@@ -0,0 +1,58 @@
+package exporters
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/steve-care-software/grammars/applications/graphs"
+)
+
+type mermaidExporter struct {
+}
+
+func createMermaid() GraphExporter {
+	out := mermaidExporter{}
+	return &out
+}
+
+// Export exports the graph to a Mermaid flowchart, where the cycles and the unreachable tokens use their own classes
+func (app *mermaidExporter) Export(graph graphs.Graph) ([]byte, error) {
+	indexes, cycles, unreachable := graphIndexes(graph)
+	output := bytes.NewBuffer(nil)
+	output.WriteString("flowchart LR\n")
+	for idx, oneNode := range graph.Nodes() {
+		output.WriteString(fmt.Sprintf("\tn%d[\"%s\"]\n", idx, strings.ReplaceAll(oneNode.Name(), "\"", "#quot;")))
+	}
+
+	for _, oneEdge := range graph.Edges() {
+		from := indexes[oneEdge.From()]
+		to := indexes[oneEdge.To()]
+		label := edgeLabels[oneEdge.Kind()]
+		if oneEdge.Kind() == graphs.KindChannel || oneEdge.Kind() == graphs.KindCondition {
+			output.WriteString(fmt.Sprintf("\tn%d -. %s .-> n%d\n", from, label, to))
+			continue
+		}
+
+		if label != "" {
+			output.WriteString(fmt.Sprintf("\tn%d -- %s --> n%d\n", from, label, to))
+			continue
+		}
+
+		output.WriteString(fmt.Sprintf("\tn%d --> n%d\n", from, to))
+	}
+
+	output.WriteString("\tclassDef cycle stroke:#d33,stroke-width:2px\n")
+	output.WriteString("\tclassDef unreachable stroke-dasharray:5 5\n")
+	for idx, oneNode := range graph.Nodes() {
+		if _, ok := cycles[oneNode]; ok {
+			output.WriteString(fmt.Sprintf("\tclass n%d cycle\n", idx))
+		}
+
+		if unreachable[oneNode] {
+			output.WriteString(fmt.Sprintf("\tclass n%d unreachable\n", idx))
+		}
+	}
+
+	return output.Bytes(), nil
+}
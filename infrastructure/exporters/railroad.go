@@ -0,0 +1,396 @@
+package exporters
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/references"
+)
+
+const railroadCharWidth = 8
+const railroadBoxHalf = 11
+const railroadGap = 10
+const railroadArc = 10
+const railroadMargin = 20
+const railroadLabelHeight = 14
+
+const railroadStyle = `path { stroke: #333; stroke-width: 1.5; fill: none; }
+rect { stroke: #333; stroke-width: 1.5; fill: #eef; }
+rect.terminal { fill: #efe; }
+rect.everything { fill: #fee; }
+text { font: 12px monospace; text-anchor: middle; dominant-baseline: central; }
+text.label { font-size: 10px; fill: #666; }`
+
+type railroadExporter struct {
+}
+
+// railroadItem represents a part of a railroad diagram, drawn from its entry point on its center line
+type railroadItem interface {
+	width() int
+	up() int
+	down() int
+	render(output *bytes.Buffer, x int, y int)
+}
+
+type railroadBox struct {
+	label string
+	class string
+}
+
+type railroadSkip struct {
+}
+
+type railroadSequence struct {
+	items []railroadItem
+}
+
+type railroadChoice struct {
+	branches []railroadItem
+}
+
+type railroadLoop struct {
+	item  railroadItem
+	label string
+}
+
+func createRailroad() RailroadExporter {
+	out := railroadExporter{}
+	return &out
+}
+
+// Export exports the railroad diagram of a token to SVG, using the names of the reference
+func (app *railroadExporter) Export(reference references.Reference, token grammars.Token) ([]byte, error) {
+	item, err := app.token(reference, token)
+	if err != nil {
+		return nil, err
+	}
+
+	name := reference.Tokens().Name(token)
+	width := item.width() + 4*railroadMargin
+	height := item.up() + item.down() + 2*railroadMargin
+	y := item.up() + railroadMargin
+	output := bytes.NewBuffer(nil)
+	output.WriteString(fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, width, height, width, height))
+	output.WriteString("\n")
+	output.WriteString(fmt.Sprintf("<title>%s</title>\n<style>\n%s\n</style>\n", html.EscapeString(name), railroadStyle))
+
+	// the start and end markers surround the token:
+	output.WriteString(fmt.Sprintf(`<path d="M%d %dv%d M%d %dv%d"/>`, railroadMargin, y-railroadArc, 2*railroadArc, railroadMargin+4, y-railroadArc, 2*railroadArc))
+	output.WriteString("\n")
+	output.WriteString(fmt.Sprintf(`<path d="M%d %dh%d"/>`, railroadMargin, y, railroadMargin))
+	output.WriteString("\n")
+	item.render(output, 2*railroadMargin, y)
+	end := 2*railroadMargin + item.width()
+	output.WriteString(fmt.Sprintf(`<path d="M%d %dh%d M%d %dv%d M%d %dv%d"/>`, end, y, railroadMargin, end+railroadMargin-4, y-railroadArc, 2*railroadArc, end+railroadMargin, y-railroadArc, 2*railroadArc))
+	output.WriteString("\n</svg>\n")
+	return output.Bytes(), nil
+}
+
+func (app *railroadExporter) token(reference references.Reference, token grammars.Token) (railroadItem, error) {
+	lines := token.Lines()
+	if len(lines) <= 0 {
+		str := fmt.Sprintf("the token (name: %s) must contain at least 1 line in order to be drawn", reference.Tokens().Name(token))
+		return nil, errors.New(str)
+	}
+
+	branches := []railroadItem{}
+	for _, oneLine := range lines {
+		items := []railroadItem{}
+		for _, oneElement := range oneLine.Elements() {
+			items = append(items, app.element(reference, oneElement))
+		}
+
+		branches = append(branches, &railroadSequence{
+			items: items,
+		})
+	}
+
+	if len(branches) == 1 {
+		return branches[0], nil
+	}
+
+	return &railroadChoice{
+		branches: branches,
+	}, nil
+}
+
+// element returns the content of the element, bypassed when optional and looped when repeated
+func (app *railroadExporter) element(reference references.Reference, element grammars.Element) railroadItem {
+	item := app.content(reference, element.Content())
+	cardinality := element.Cardinality()
+	min := cardinality.Min()
+	if cardinality.HasMax() && *cardinality.Max() <= 1 {
+		if min >= 1 {
+			return item
+		}
+
+		return &railroadChoice{
+			branches: []railroadItem{&railroadSkip{}, item},
+		}
+	}
+
+	label := ""
+	if cardinality.HasMax() {
+		label = fmt.Sprintf("%d to %d times", min, *cardinality.Max())
+		if min == *cardinality.Max() {
+			label = fmt.Sprintf("%d times", min)
+		}
+	} else if min > 1 {
+		label = fmt.Sprintf("%d or more times", min)
+	}
+
+	loop := &railroadLoop{
+		item:  item,
+		label: label,
+	}
+
+	if min >= 1 {
+		return loop
+	}
+
+	return &railroadChoice{
+		branches: []railroadItem{&railroadSkip{}, loop},
+	}
+}
+
+func (app *railroadExporter) content(reference references.Reference, content grammars.ElementContent) railroadItem {
+	if content.IsValue() {
+		return &railroadBox{
+			label: valueLabel(content.Value()),
+			class: "terminal",
+		}
+	}
+
+	if content.IsGrammar() {
+		return &railroadBox{
+			label: fmt.Sprintf("{%s}", reference.Tokens().Name(content.Grammar().Root())),
+			class: "",
+		}
+	}
+
+	if content.IsRecursive() {
+		return &railroadBox{
+			label: content.Recursive(),
+			class: "",
+		}
+	}
+
+	instance := content.Instance()
+	if instance.IsToken() {
+		return &railroadBox{
+			label: reference.Tokens().Name(instance.Token()),
+			class: "",
+		}
+	}
+
+	everything := instance.Everything()
+	label := fmt.Sprintf("anything except %s", reference.Tokens().Name(everything.Exception()))
+	if everything.HasEscape() {
+		label = fmt.Sprintf("%s, escaped by %s", label, reference.Tokens().Name(everything.Escape()))
+	}
+
+	return &railroadBox{
+		label: label,
+		class: "everything",
+	}
+}
+
+func (obj *railroadBox) width() int {
+	return len(obj.label)*railroadCharWidth + 2*railroadGap
+}
+
+func (obj *railroadBox) up() int {
+	return railroadBoxHalf
+}
+
+func (obj *railroadBox) down() int {
+	return railroadBoxHalf
+}
+
+func (obj *railroadBox) render(output *bytes.Buffer, x int, y int) {
+	radius := 0
+	if obj.class == "terminal" {
+		radius = railroadBoxHalf
+	}
+
+	output.WriteString(fmt.Sprintf(`<rect class="%s" x="%d" y="%d" width="%d" height="%d" rx="%d"/>`, obj.class, x, y-railroadBoxHalf, obj.width(), 2*railroadBoxHalf, radius))
+	output.WriteString(fmt.Sprintf(`<text x="%d" y="%d">%s</text>`, x+obj.width()/2, y, html.EscapeString(obj.label)))
+	output.WriteString("\n")
+}
+
+func (obj *railroadSkip) width() int {
+	return 0
+}
+
+func (obj *railroadSkip) up() int {
+	return 0
+}
+
+func (obj *railroadSkip) down() int {
+	return 0
+}
+
+func (obj *railroadSkip) render(output *bytes.Buffer, x int, y int) {
+}
+
+func (obj *railroadSequence) width() int {
+	total := 0
+	for idx, oneItem := range obj.items {
+		if idx > 0 {
+			total += railroadGap
+		}
+
+		total += oneItem.width()
+	}
+
+	return total
+}
+
+func (obj *railroadSequence) up() int {
+	max := 0
+	for _, oneItem := range obj.items {
+		if oneItem.up() > max {
+			max = oneItem.up()
+		}
+	}
+
+	return max
+}
+
+func (obj *railroadSequence) down() int {
+	max := 0
+	for _, oneItem := range obj.items {
+		if oneItem.down() > max {
+			max = oneItem.down()
+		}
+	}
+
+	return max
+}
+
+func (obj *railroadSequence) render(output *bytes.Buffer, x int, y int) {
+	for idx, oneItem := range obj.items {
+		if idx > 0 {
+			output.WriteString(fmt.Sprintf(`<path d="M%d %dh%d"/>`, x, y, railroadGap))
+			output.WriteString("\n")
+			x += railroadGap
+		}
+
+		oneItem.render(output, x, y)
+		x += oneItem.width()
+	}
+}
+
+// offsets returns the distance between the center line of the choice and the center line of each branch
+func (obj *railroadChoice) offsets() []int {
+	output := []int{}
+	offset := 0
+	for idx, oneBranch := range obj.branches {
+		if idx > 0 {
+			offset += obj.branches[idx-1].down() + railroadGap + oneBranch.up()
+			if offset < 2*railroadArc {
+				offset = 2 * railroadArc
+			}
+		}
+
+		output = append(output, offset)
+	}
+
+	return output
+}
+
+func (obj *railroadChoice) width() int {
+	max := 0
+	for _, oneBranch := range obj.branches {
+		if oneBranch.width() > max {
+			max = oneBranch.width()
+		}
+	}
+
+	return max + 4*railroadArc
+}
+
+func (obj *railroadChoice) up() int {
+	return obj.branches[0].up()
+}
+
+func (obj *railroadChoice) down() int {
+	offsets := obj.offsets()
+	last := len(obj.branches) - 1
+	return offsets[last] + obj.branches[last].down()
+}
+
+func (obj *railroadChoice) render(output *bytes.Buffer, x int, y int) {
+	width := obj.width()
+	for idx, oneBranch := range obj.branches {
+		offset := obj.offsets()[idx]
+		start := x + 2*railroadArc
+		end := x + width - 2*railroadArc
+		if offset == 0 {
+			output.WriteString(fmt.Sprintf(`<path d="M%d %dh%d"/>`, x, y, 2*railroadArc))
+		} else {
+			output.WriteString(fmt.Sprintf(`<path d="M%d %dq%d 0 %d %dv%dq0 %d %d %d"/>`, x, y, railroadArc, railroadArc, railroadArc, offset-2*railroadArc, railroadArc, railroadArc, railroadArc))
+		}
+
+		output.WriteString("\n")
+		oneBranch.render(output, start, y+offset)
+		remaining := end - start - oneBranch.width()
+		if remaining > 0 {
+			output.WriteString(fmt.Sprintf(`<path d="M%d %dh%d"/>`, start+oneBranch.width(), y+offset, remaining))
+		}
+
+		if offset == 0 {
+			output.WriteString(fmt.Sprintf(`<path d="M%d %dh%d"/>`, end, y, 2*railroadArc))
+		} else {
+			output.WriteString(fmt.Sprintf(`<path d="M%d %dq%d 0 %d %dv%dq0 %d %d %d"/>`, end, y+offset, railroadArc, railroadArc, -railroadArc, -(offset - 2*railroadArc), -railroadArc, railroadArc, -railroadArc))
+		}
+
+		output.WriteString("\n")
+	}
+}
+
+func (obj *railroadLoop) width() int {
+	return obj.item.width() + 2*railroadArc
+}
+
+func (obj *railroadLoop) up() int {
+	return obj.item.up()
+}
+
+func (obj *railroadLoop) down() int {
+	down := obj.item.down() + railroadGap + railroadArc
+	if obj.label != "" {
+		down += railroadLabelHeight
+	}
+
+	return down
+}
+
+func (obj *railroadLoop) render(output *bytes.Buffer, x int, y int) {
+	width := obj.width()
+	bottom := obj.item.down() + railroadGap
+	output.WriteString(fmt.Sprintf(`<path d="M%d %dh%d"/>`, x, y, railroadArc))
+	obj.item.render(output, x+railroadArc, y)
+	output.WriteString(fmt.Sprintf(`<path d="M%d %dh%d"/>`, x+railroadArc+obj.item.width(), y, railroadArc))
+
+	// the loop goes back from the end of the item to its start, below it:
+	output.WriteString(fmt.Sprintf(`<path d="M%d %dq%d 0 %d %dv%dq0 %d %d %dh%dq%d 0 %d %dv%dq0 %d %d %d"/>`,
+		x+width-railroadArc, y,
+		railroadArc, railroadArc, railroadArc,
+		bottom-2*railroadArc,
+		railroadArc, -railroadArc, railroadArc,
+		-(width - 2*railroadArc),
+		-railroadArc, -railroadArc, -railroadArc,
+		-(bottom - 2*railroadArc),
+		-railroadArc, railroadArc, -railroadArc,
+	))
+
+	output.WriteString("\n")
+	if obj.label != "" {
+		output.WriteString(fmt.Sprintf(`<text class="label" x="%d" y="%d">%s</text>`, x+width/2, y+bottom+railroadArc+railroadLabelHeight/2, html.EscapeString(obj.label)))
+		output.WriteString("\n")
+	}
+}
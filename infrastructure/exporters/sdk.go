@@ -0,0 +1,86 @@
+package exporters
+
+import (
+	"github.com/steve-care-software/grammars/applications/diffs"
+	"github.com/steve-care-software/grammars/applications/graphs"
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/references"
+	"github.com/steve-care-software/grammars/domain/references/coverages"
+	"github.com/steve-care-software/grammars/infrastructure/scripts"
+)
+
+const htmlCoveredClass = "covered"
+const htmlPartialClass = "partial"
+const htmlUncoveredClass = "uncovered"
+
+// NewJSON creates a new JSON exporter
+func NewJSON() Exporter {
+	return createJSON()
+}
+
+// NewJUnit creates a new JUnit XML exporter
+func NewJUnit() Exporter {
+	return createJUnit()
+}
+
+// NewLCOV creates a new LCOV exporter
+func NewLCOV() ScriptExporter {
+	source := newSource()
+	return createLCOV(source)
+}
+
+// NewHTML creates a new HTML exporter
+func NewHTML() ScriptExporter {
+	source := newSource()
+	return createHTML(source)
+}
+
+// NewDOT creates a new Graphviz DOT exporter
+func NewDOT() GraphExporter {
+	return createDOT()
+}
+
+// NewMermaid creates a new Mermaid exporter
+func NewMermaid() GraphExporter {
+	return createMermaid()
+}
+
+// NewRailroad creates a new SVG railroad diagram exporter
+func NewRailroad() RailroadExporter {
+	return createRailroad()
+}
+
+// NewChangelog creates a new markdown changelog exporter
+func NewChangelog() DiffExporter {
+	return createChangelog()
+}
+
+func newSource() *source {
+	locator := scripts.NewLocator()
+	return createSource(locator)
+}
+
+// Exporter represents a coverages exporter
+type Exporter interface {
+	Export(coverages coverages.Coverages) ([]byte, error)
+}
+
+// ScriptExporter represents an exporter of the coverages of a grammar script
+type ScriptExporter interface {
+	Export(name string, script []byte, coverages coverages.Coverages) ([]byte, error)
+}
+
+// GraphExporter represents a dependency graph exporter
+type GraphExporter interface {
+	Export(graph graphs.Graph) ([]byte, error)
+}
+
+// RailroadExporter represents a railroad diagram exporter of a token
+type RailroadExporter interface {
+	Export(reference references.Reference, token grammars.Token) ([]byte, error)
+}
+
+// DiffExporter represents an exporter of the differences between two references
+type DiffExporter interface {
+	Export(diff diffs.Diff) ([]byte, error)
+}
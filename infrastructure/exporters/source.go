@@ -0,0 +1,111 @@
+package exporters
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/steve-care-software/grammars/domain/references/coverages"
+	"github.com/steve-care-software/grammars/infrastructure/scripts"
+)
+
+type source struct {
+	locator scripts.Locator
+}
+
+// location represents the script lines of a token's line
+type location struct {
+	token     string
+	index     uint
+	start     uint
+	end       uint
+	isCovered bool
+}
+
+// listing represents the lines of a script and the locations that cover them
+type listing struct {
+	lines     []string
+	locations []location
+	tokens    []coverages.Token
+}
+
+func createSource(
+	locator scripts.Locator,
+) *source {
+	out := source{
+		locator: locator,
+	}
+
+	return &out
+}
+
+// listing parses the script and locates the covered lines of its tokens
+func (app *source) listing(script []byte, ins coverages.Coverages) (*listing, error) {
+	instructions, err := app.locator.Locate(script)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := map[string]coverages.Token{}
+	output := listing{
+		lines:     []string{},
+		locations: []location{},
+		tokens:    []coverages.Token{},
+	}
+
+	if ins.HasTokens() {
+		output.tokens = ins.Tokens()
+		for _, oneToken := range output.tokens {
+			tokens[oneToken.Reference().Name()] = oneToken
+		}
+	}
+
+	for _, oneLine := range bytes.Split(script, []byte("\n")) {
+		output.lines = append(output.lines, string(oneLine))
+	}
+
+	for _, oneInstruction := range instructions {
+		name := oneInstruction.Name()
+		token, ok := tokens[name]
+		if !ok {
+			continue
+		}
+
+		lines := token.Lines()
+		positions := oneInstruction.Lines()
+		if len(lines) != len(positions) {
+			str := fmt.Sprintf("the token (name: %s) contains %d lines in its coverage but %d in the script", name, len(lines), len(positions))
+			return nil, errors.New(str)
+		}
+
+		for idx, onePosition := range positions {
+			output.locations = append(output.locations, location{
+				token:     name,
+				index:     uint(idx),
+				start:     onePosition.Start().Line(),
+				end:       onePosition.End().Line(),
+				isCovered: lines[idx].IsCovered(),
+			})
+		}
+	}
+
+	return &output, nil
+}
+
+// hits returns the amount of covered and uncovered locations that start or span each script line
+func (obj *listing) hits() (map[uint]uint, map[uint]uint) {
+	covered := map[uint]uint{}
+	uncovered := map[uint]uint{}
+	for _, oneLocation := range obj.locations {
+		for line := oneLocation.start; line <= oneLocation.end; line++ {
+			if oneLocation.isCovered {
+				covered[line]++
+				continue
+			}
+
+			uncovered[line]++
+		}
+	}
+
+	return covered, uncovered
+}
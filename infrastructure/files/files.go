@@ -0,0 +1,72 @@
+package files
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func path(basePath string, directory string, keyname string) string {
+	return filepath.Join(basePath, directory, keyname)
+}
+
+func exists(basePath string, directory string, keyname string) (bool, error) {
+	_, err := os.Stat(path(basePath, directory, keyname))
+	if err == nil {
+		return true, nil
+	}
+
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+
+	return false, err
+}
+
+// write writes the record to a temporary file, then renames it so that a record is never partially written
+func write(basePath string, directory string, keyname string, record interface{}) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	dirPath := filepath.Join(basePath, directory)
+	err = os.MkdirAll(dirPath, 0755)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(dirPath, temporaryPattern)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+
+	return os.Rename(file.Name(), path(basePath, directory, keyname))
+}
+
+func read(basePath string, directory string, keyname string, pRecord interface{}) error {
+	data, err := os.ReadFile(path(basePath, directory, keyname))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			str := fmt.Sprintf("the record (directory: %s, hash: %s) does not exist in the repository", directory, keyname)
+			return errors.New(str)
+		}
+
+		return err
+	}
+
+	return json.Unmarshal(data, pRecord)
+}
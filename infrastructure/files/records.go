@@ -0,0 +1,52 @@
+package files
+
+type grammarRecord struct {
+	Root     string          `json:"root"`
+	Channels []channelRecord `json:"channels,omitempty"`
+}
+
+type channelRecord struct {
+	Token    string `json:"token"`
+	Previous string `json:"previous,omitempty"`
+	Next     string `json:"next,omitempty"`
+}
+
+type tokenRecord struct {
+	Name   string        `json:"name,omitempty"`
+	Lines  []string      `json:"lines"`
+	Suites []suiteRecord `json:"suites,omitempty"`
+}
+
+type suiteRecord struct {
+	IsValid bool   `json:"valid"`
+	Content []byte `json:"content"`
+}
+
+type lineRecord struct {
+	Elements []string `json:"elements"`
+}
+
+type elementRecord struct {
+	Min        uint              `json:"min"`
+	Max        *uint             `json:"max,omitempty"`
+	Value      []byte            `json:"value,omitempty"`
+	Grammar    string            `json:"grammar,omitempty"`
+	Token      string            `json:"token,omitempty"`
+	Everything *everythingRecord `json:"everything,omitempty"`
+	Recursive  string            `json:"recursive,omitempty"`
+}
+
+type everythingRecord struct {
+	Exception string `json:"exception"`
+	Escape    string `json:"escape,omitempty"`
+}
+
+type referenceRecord struct {
+	Tokens   []namedRecord `json:"tokens"`
+	Grammars []namedRecord `json:"grammars,omitempty"`
+}
+
+type namedRecord struct {
+	Name string `json:"name"`
+	Hash string `json:"hash"`
+}
@@ -0,0 +1,176 @@
+package files
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/references"
+	"github.com/steve-care-software/libs/cryptography/hash"
+)
+
+type referenceRepository struct {
+	hashAdapter     hash.Adapter
+	repository      grammars.Repository
+	builder         references.Builder
+	tokensBuilder   references.TokensBuilder
+	tokenBuilder    references.TokenBuilder
+	grammarsBuilder references.GrammarsBuilder
+	grammarBuilder  references.GrammarBuilder
+	basePath        string
+}
+
+func createReferenceRepository(
+	hashAdapter hash.Adapter,
+	repository grammars.Repository,
+	builder references.Builder,
+	tokensBuilder references.TokensBuilder,
+	tokenBuilder references.TokenBuilder,
+	grammarsBuilder references.GrammarsBuilder,
+	grammarBuilder references.GrammarBuilder,
+	basePath string,
+) references.Repository {
+	out := referenceRepository{
+		hashAdapter:     hashAdapter,
+		repository:      repository,
+		builder:         builder,
+		tokensBuilder:   tokensBuilder,
+		tokenBuilder:    tokenBuilder,
+		grammarsBuilder: grammarsBuilder,
+		grammarBuilder:  grammarBuilder,
+		basePath:        basePath,
+	}
+
+	return &out
+}
+
+// Retrieve retrieves a reference by the hash of its root grammar
+func (app *referenceRepository) Retrieve(hash hash.Hash) (references.Reference, error) {
+	record := referenceRecord{}
+	err := read(app.basePath, referencesDirectory, hash.String(), &record)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := app.repository.Retrieve(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	retrieved := map[string]grammars.Token{}
+	app.grammar(root, retrieved)
+	tokensList := []references.Token{}
+	for _, oneRecord := range record.Tokens {
+		token, ok := retrieved[oneRecord.Hash]
+		if !ok {
+			pHash, err := app.hashAdapter.FromString(oneRecord.Hash)
+			if err != nil {
+				return nil, err
+			}
+
+			token, err = app.repository.RetrieveToken(*pHash)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		referenceToken, err := app.tokenBuilder.Create().WithName(oneRecord.Name).WithReference(token).Now()
+		if err != nil {
+			return nil, err
+		}
+
+		tokensList = append(tokensList, referenceToken)
+	}
+
+	tokens, err := app.tokensBuilder.Create().WithList(tokensList).Now()
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.builder.Create().WithRoot(root).WithTokens(tokens)
+	if len(record.Grammars) <= 0 {
+		return builder.Now()
+	}
+
+	grammarsList := []references.Grammar{}
+	for _, oneRecord := range record.Grammars {
+		pHash, err := app.hashAdapter.FromString(oneRecord.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		grammar, err := app.repository.Retrieve(*pHash)
+		if err != nil {
+			return nil, err
+		}
+
+		referenceGrammar, err := app.grammarBuilder.Create().WithName(oneRecord.Name).WithReference(grammar).Now()
+		if err != nil {
+			return nil, err
+		}
+
+		grammarsList = append(grammarsList, referenceGrammar)
+	}
+
+	grammarsIns, err := app.grammarsBuilder.Create().WithList(grammarsList).Now()
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.WithGrammars(grammarsIns).Now()
+}
+
+// grammar adds the tokens of the retrieved grammar to the map, so that they are not retrieved again
+func (app *referenceRepository) grammar(grammar grammars.Grammar, retrieved map[string]grammars.Token) {
+	app.token(grammar.Root(), retrieved)
+	if !grammar.HasChannels() {
+		return
+	}
+
+	for _, oneChannel := range grammar.Channels() {
+		app.token(oneChannel.Token(), retrieved)
+		if !oneChannel.HasCondition() {
+			continue
+		}
+
+		condition := oneChannel.Condition()
+		if condition.HasPrevious() {
+			app.token(condition.Previous(), retrieved)
+		}
+
+		if condition.HasNext() {
+			app.token(condition.Next(), retrieved)
+		}
+	}
+}
+
+func (app *referenceRepository) token(token grammars.Token, retrieved map[string]grammars.Token) {
+	keyname := token.Hash().String()
+	if _, ok := retrieved[keyname]; ok {
+		return
+	}
+
+	retrieved[keyname] = token
+	for _, oneLine := range token.Lines() {
+		for _, oneElement := range oneLine.Elements() {
+			content := oneElement.Content()
+			if content.IsGrammar() {
+				app.grammar(content.Grammar(), retrieved)
+				continue
+			}
+
+			if !content.IsInstance() {
+				continue
+			}
+
+			instance := content.Instance()
+			if instance.IsToken() {
+				app.token(instance.Token(), retrieved)
+				continue
+			}
+
+			everything := instance.Everything()
+			app.token(everything.Exception(), retrieved)
+			if everything.HasEscape() {
+				app.token(everything.Escape(), retrieved)
+			}
+		}
+	}
+}
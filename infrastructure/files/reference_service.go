@@ -0,0 +1,67 @@
+package files
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/references"
+)
+
+type referenceService struct {
+	service  grammars.Service
+	basePath string
+}
+
+func createReferenceService(
+	service grammars.Service,
+	basePath string,
+) references.Service {
+	out := referenceService{
+		service:  service,
+		basePath: basePath,
+	}
+
+	return &out
+}
+
+// Insert inserts the grammars and tokens of the reference, then its names under the hash of its root grammar
+func (app *referenceService) Insert(reference references.Reference) error {
+	err := app.service.Insert(reference.Root())
+	if err != nil {
+		return err
+	}
+
+	record := referenceRecord{
+		Tokens:   []namedRecord{},
+		Grammars: []namedRecord{},
+	}
+
+	for _, oneToken := range reference.Tokens().List() {
+		token := oneToken.Reference()
+		err := app.service.InsertToken(token)
+		if err != nil {
+			return err
+		}
+
+		record.Tokens = append(record.Tokens, namedRecord{
+			Name: oneToken.Name(),
+			Hash: token.Hash().String(),
+		})
+	}
+
+	if reference.HasGrammars() {
+		for _, oneGrammar := range reference.Grammars().List() {
+			grammar := oneGrammar.Reference()
+			err := app.service.Insert(grammar)
+			if err != nil {
+				return err
+			}
+
+			record.Grammars = append(record.Grammars, namedRecord{
+				Name: oneGrammar.Name(),
+				Hash: grammar.Hash().String(),
+			})
+		}
+	}
+
+	// the names are not part of the content, therefore the names of the last inserted reference are kept:
+	return write(app.basePath, referencesDirectory, reference.Root().Hash().String(), record)
+}
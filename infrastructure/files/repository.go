@@ -0,0 +1,379 @@
+package files
+
+import (
+	"errors"
+	"fmt"
+
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/libs/cryptography/hash"
+)
+
+type repository struct {
+	hashAdapter             hash.Adapter
+	builder                 grammars.Builder
+	channelBuilder          grammars.ChannelBuilder
+	channelConditionBuilder grammars.ChannelConditionBuilder
+	tokenBuilder            grammars.TokenBuilder
+	suiteBuilder            grammars.SuiteBuilder
+	lineBuilder             grammars.LineBuilder
+	elementBuilder          grammars.ElementBuilder
+	instanceBuilder         grammars.InstanceBuilder
+	everythingBuilder       grammars.EverythingBuilder
+	cardinalityBuilder      grammars.CardinalityBuilder
+	basePath                string
+}
+
+// retrieval keeps the instances already retrieved, so that a token shared by many elements is only read once
+type retrieval struct {
+	grammars map[string]grammars.Grammar
+	tokens   map[string]grammars.Token
+	lines    map[string]grammars.Line
+	elements map[string]grammars.Element
+}
+
+func createRepository(
+	hashAdapter hash.Adapter,
+	builder grammars.Builder,
+	channelBuilder grammars.ChannelBuilder,
+	channelConditionBuilder grammars.ChannelConditionBuilder,
+	tokenBuilder grammars.TokenBuilder,
+	suiteBuilder grammars.SuiteBuilder,
+	lineBuilder grammars.LineBuilder,
+	elementBuilder grammars.ElementBuilder,
+	instanceBuilder grammars.InstanceBuilder,
+	everythingBuilder grammars.EverythingBuilder,
+	cardinalityBuilder grammars.CardinalityBuilder,
+	basePath string,
+) grammars.Repository {
+	out := repository{
+		hashAdapter:             hashAdapter,
+		builder:                 builder,
+		channelBuilder:          channelBuilder,
+		channelConditionBuilder: channelConditionBuilder,
+		tokenBuilder:            tokenBuilder,
+		suiteBuilder:            suiteBuilder,
+		lineBuilder:             lineBuilder,
+		elementBuilder:          elementBuilder,
+		instanceBuilder:         instanceBuilder,
+		everythingBuilder:       everythingBuilder,
+		cardinalityBuilder:      cardinalityBuilder,
+		basePath:                basePath,
+	}
+
+	return &out
+}
+
+// Retrieve retrieves a grammar by hash
+func (app *repository) Retrieve(hash hash.Hash) (grammars.Grammar, error) {
+	return app.grammar(app.retrieval(), hash.String())
+}
+
+// RetrieveToken retrieves a token by hash
+func (app *repository) RetrieveToken(hash hash.Hash) (grammars.Token, error) {
+	return app.token(app.retrieval(), hash.String())
+}
+
+// RetrieveLine retrieves a line by hash
+func (app *repository) RetrieveLine(hash hash.Hash) (grammars.Line, error) {
+	return app.line(app.retrieval(), hash.String())
+}
+
+// RetrieveElement retrieves an element by hash
+func (app *repository) RetrieveElement(hash hash.Hash) (grammars.Element, error) {
+	return app.element(app.retrieval(), hash.String())
+}
+
+func (app *repository) retrieval() *retrieval {
+	return &retrieval{
+		grammars: map[string]grammars.Grammar{},
+		tokens:   map[string]grammars.Token{},
+		lines:    map[string]grammars.Line{},
+		elements: map[string]grammars.Element{},
+	}
+}
+
+func (app *repository) grammar(state *retrieval, keyname string) (grammars.Grammar, error) {
+	if grammar, ok := state.grammars[keyname]; ok {
+		return grammar, nil
+	}
+
+	record := grammarRecord{}
+	err := read(app.basePath, grammarsDirectory, keyname, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := app.token(state, record.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	channels := []grammars.Channel{}
+	for _, oneRecord := range record.Channels {
+		channel, err := app.channel(state, oneRecord)
+		if err != nil {
+			return nil, err
+		}
+
+		channels = append(channels, channel)
+	}
+
+	builder := app.builder.Create().WithRoot(root)
+	if len(channels) > 0 {
+		builder.WithChannels(channels)
+	}
+
+	grammar, err := builder.Now()
+	if err != nil {
+		return nil, err
+	}
+
+	err = app.verify(grammarsDirectory, keyname, grammar.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	state.grammars[keyname] = grammar
+	return grammar, nil
+}
+
+func (app *repository) channel(state *retrieval, record channelRecord) (grammars.Channel, error) {
+	token, err := app.token(state, record.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.channelBuilder.Create().WithToken(token)
+	if record.Previous == "" && record.Next == "" {
+		return builder.Now()
+	}
+
+	conditionBuilder := app.channelConditionBuilder.Create()
+	if record.Previous != "" {
+		previous, err := app.token(state, record.Previous)
+		if err != nil {
+			return nil, err
+		}
+
+		conditionBuilder.WithPrevious(previous)
+	}
+
+	if record.Next != "" {
+		next, err := app.token(state, record.Next)
+		if err != nil {
+			return nil, err
+		}
+
+		conditionBuilder.WithNext(next)
+	}
+
+	condition, err := conditionBuilder.Now()
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.WithCondition(condition).Now()
+}
+
+func (app *repository) token(state *retrieval, keyname string) (grammars.Token, error) {
+	if token, ok := state.tokens[keyname]; ok {
+		return token, nil
+	}
+
+	record := tokenRecord{}
+	err := read(app.basePath, tokensDirectory, keyname, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := []grammars.Line{}
+	for _, oneLine := range record.Lines {
+		line, err := app.line(state, oneLine)
+		if err != nil {
+			return nil, err
+		}
+
+		lines = append(lines, line)
+	}
+
+	suites := []grammars.Suite{}
+	for _, oneRecord := range record.Suites {
+		builder := app.suiteBuilder.Create()
+		if oneRecord.IsValid {
+			builder.WithValid(oneRecord.Content)
+		} else {
+			builder.WithInvalid(oneRecord.Content)
+		}
+
+		suite, err := builder.Now()
+		if err != nil {
+			return nil, err
+		}
+
+		suites = append(suites, suite)
+	}
+
+	builder := app.tokenBuilder.Create().WithLines(lines).WithSuites(suites)
+	if record.Name != "" {
+		builder.WithName(record.Name)
+	}
+
+	token, err := builder.Now()
+	if err != nil {
+		return nil, err
+	}
+
+	err = app.verify(tokensDirectory, keyname, token.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	state.tokens[keyname] = token
+	return token, nil
+}
+
+func (app *repository) line(state *retrieval, keyname string) (grammars.Line, error) {
+	if line, ok := state.lines[keyname]; ok {
+		return line, nil
+	}
+
+	record := lineRecord{}
+	err := read(app.basePath, linesDirectory, keyname, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	elements := []grammars.Element{}
+	for _, oneElement := range record.Elements {
+		element, err := app.element(state, oneElement)
+		if err != nil {
+			return nil, err
+		}
+
+		elements = append(elements, element)
+	}
+
+	line, err := app.lineBuilder.Create().WithElements(elements).Now()
+	if err != nil {
+		return nil, err
+	}
+
+	err = app.verify(linesDirectory, keyname, line.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	state.lines[keyname] = line
+	return line, nil
+}
+
+func (app *repository) element(state *retrieval, keyname string) (grammars.Element, error) {
+	if element, ok := state.elements[keyname]; ok {
+		return element, nil
+	}
+
+	record := elementRecord{}
+	err := read(app.basePath, elementsDirectory, keyname, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	cardinalityBuilder := app.cardinalityBuilder.Create().WithMin(record.Min)
+	if record.Max != nil {
+		cardinalityBuilder.WithMax(*record.Max)
+	}
+
+	cardinality, err := cardinalityBuilder.Now()
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.elementBuilder.Create().WithCardinality(cardinality)
+	if len(record.Value) > 0 {
+		builder.WithValue(record.Value)
+	}
+
+	if record.Recursive != "" {
+		builder.WithRecursive(record.Recursive)
+	}
+
+	if record.Grammar != "" {
+		grammar, err := app.grammar(state, record.Grammar)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithGrammar(grammar)
+	}
+
+	if record.Token != "" || record.Everything != nil {
+		instance, err := app.instance(state, record)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithInstance(instance)
+	}
+
+	element, err := builder.Now()
+	if err != nil {
+		return nil, err
+	}
+
+	err = app.verify(elementsDirectory, keyname, element.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	state.elements[keyname] = element
+	return element, nil
+}
+
+func (app *repository) instance(state *retrieval, record elementRecord) (grammars.Instance, error) {
+	builder := app.instanceBuilder.Create()
+	if record.Token != "" {
+		token, err := app.token(state, record.Token)
+		if err != nil {
+			return nil, err
+		}
+
+		return builder.WithToken(token).Now()
+	}
+
+	exception, err := app.token(state, record.Everything.Exception)
+	if err != nil {
+		return nil, err
+	}
+
+	everythingBuilder := app.everythingBuilder.Create().WithException(exception)
+	if record.Everything.Escape != "" {
+		escape, err := app.token(state, record.Everything.Escape)
+		if err != nil {
+			return nil, err
+		}
+
+		everythingBuilder.WithEscape(escape)
+	}
+
+	everything, err := everythingBuilder.Now()
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.WithEverything(everything).Now()
+}
+
+// verify returns an error if the rebuilt instance does not match the hash it is stored under
+func (app *repository) verify(directory string, keyname string, rebuilt hash.Hash) error {
+	pHash, err := app.hashAdapter.FromString(keyname)
+	if err != nil {
+		return err
+	}
+
+	if !pHash.Compare(rebuilt) {
+		str := fmt.Sprintf("the record (directory: %s, hash: %s) is corrupted, its content rebuilds the hash: %s", directory, keyname, rebuilt.String())
+		return errors.New(str)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package files_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steve-care-software/grammars/infrastructure/files"
+	"github.com/steve-care-software/grammars/infrastructure/scripts"
+)
+
+func TestRepository_Success(t *testing.T) {
+	basePath := t.TempDir()
+	reference := scripts.NewGrammar().Grammar()
+	referenceService := files.NewReferenceService(basePath)
+	err := referenceService.Insert(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	paths, err := filepath.Glob(filepath.Join(basePath, "*", "*"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = referenceService.Insert(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	again, err := filepath.Glob(filepath.Join(basePath, "*", "*"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(paths) != len(again) {
+		t.Errorf("inserting the same reference twice was expected to store %d files, %d stored", len(paths), len(again))
+		return
+	}
+
+	retrieved, err := files.NewReferenceRepository(basePath).Retrieve(reference.Root().Hash())
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !retrieved.Root().Hash().Compare(reference.Root().Hash()) {
+		t.Errorf("the retrieved grammar was expected to have the hash of the inserted grammar")
+		return
+	}
+
+	decompiler := scripts.NewDecompiler()
+	expected, err := decompiler.Decompile(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	script, err := decompiler.Decompile(retrieved)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(expected, script) {
+		t.Errorf("the retrieved reference was expected to decompile to: \n%s\nreturned: \n%s", expected, script)
+		return
+	}
+
+	// a token whose content no longer matches its hash is rejected:
+	root := reference.Root().Root()
+	tokenPath := filepath.Join(basePath, "tokens", root.Hash().String())
+	data, err := os.ReadFile(tokenPath)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	record := map[string]interface{}{}
+	err = json.Unmarshal(data, &record)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	record["name"] = "changed"
+	data, err = json.Marshal(record)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	err = os.WriteFile(tokenPath, data, 0644)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	_, err = files.NewRepository(basePath).RetrieveToken(root.Hash())
+	if err == nil || !strings.Contains(err.Error(), "is corrupted") {
+		t.Errorf("the corrupted token was expected to be rejected, error returned: %v", err)
+		return
+	}
+
+	_, err = files.NewRepository(t.TempDir()).Retrieve(reference.Root().Hash())
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestRepository_withSharedToken_isStoredOnce(t *testing.T) {
+	inputs := []string{
+		`
+			@first;
+			first: number plus;
+			number: one | two;
+			one: 49;
+			two: 50;
+			plus: 43;
+		`,
+		`
+			@second;
+			second: number minus;
+			number: one | two;
+			one: 49;
+			two: 50;
+			minus: 45;
+		`,
+	}
+
+	basePath := t.TempDir()
+	service := files.NewService(basePath)
+	tokens := map[string]bool{}
+	for _, oneScript := range inputs {
+		reference, err := scripts.NewCompiler().Compile([]byte(oneScript))
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		for _, oneToken := range reference.Tokens().List() {
+			tokens[oneToken.Reference().Hash().String()] = true
+		}
+
+		err = service.Insert(reference.Root())
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+	}
+
+	// the number token is shared by both grammars, therefore there is 1 token less than the tokens of both references:
+	if len(tokens) != 3 {
+		t.Errorf("the references were expected to contain %d distinct tokens, %d returned", 3, len(tokens))
+		return
+	}
+
+	paths, err := filepath.Glob(filepath.Join(basePath, "tokens", "*"))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if len(paths) != len(tokens) {
+		t.Errorf("the tokens were expected to be stored in %d files, %d stored", len(tokens), len(paths))
+		return
+	}
+}
@@ -0,0 +1,75 @@
+package files
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/references"
+	"github.com/steve-care-software/libs/cryptography/hash"
+)
+
+const grammarsDirectory = "grammars"
+const tokensDirectory = "tokens"
+const linesDirectory = "lines"
+const elementsDirectory = "elements"
+const referencesDirectory = "references"
+const temporaryPattern = "*.tmp"
+
+// NewRepository creates a new grammar repository stored in the base path
+func NewRepository(basePath string) grammars.Repository {
+	hashAdapter := hash.NewAdapter()
+	builder := grammars.NewBuilder()
+	channelBuilder := grammars.NewChannelBuilder()
+	channelConditionBuilder := grammars.NewChannelConditionBuilder()
+	tokenBuilder := grammars.NewTokenBuilder()
+	suiteBuilder := grammars.NewSuiteBuilder()
+	lineBuilder := grammars.NewLineBuilder()
+	elementBuilder := grammars.NewElementBuilder()
+	instanceBuilder := grammars.NewInstanceBuilder()
+	everythingBuilder := grammars.NewEverythingBuilder()
+	cardinalityBuilder := grammars.NewCardinalityBuilder()
+	return createRepository(
+		hashAdapter,
+		builder,
+		channelBuilder,
+		channelConditionBuilder,
+		tokenBuilder,
+		suiteBuilder,
+		lineBuilder,
+		elementBuilder,
+		instanceBuilder,
+		everythingBuilder,
+		cardinalityBuilder,
+		basePath,
+	)
+}
+
+// NewService creates a new grammar service stored in the base path
+func NewService(basePath string) grammars.Service {
+	return createService(basePath)
+}
+
+// NewReferenceRepository creates a new reference repository stored in the base path
+func NewReferenceRepository(basePath string) references.Repository {
+	hashAdapter := hash.NewAdapter()
+	repository := NewRepository(basePath)
+	builder := references.NewBuilder()
+	tokensBuilder := references.NewTokensBuilder()
+	tokenBuilder := references.NewTokenBuilder()
+	grammarsBuilder := references.NewGrammarsBuilder()
+	grammarBuilder := references.NewGrammarBuilder()
+	return createReferenceRepository(
+		hashAdapter,
+		repository,
+		builder,
+		tokensBuilder,
+		tokenBuilder,
+		grammarsBuilder,
+		grammarBuilder,
+		basePath,
+	)
+}
+
+// NewReferenceService creates a new reference service stored in the base path
+func NewReferenceService(basePath string) references.Service {
+	service := NewService(basePath)
+	return createReferenceService(service, basePath)
+}
@@ -0,0 +1,229 @@
+package files
+
+import (
+	grammars "github.com/steve-care-software/grammars/domain"
+)
+
+type service struct {
+	basePath string
+}
+
+func createService(
+	basePath string,
+) grammars.Service {
+	out := service{
+		basePath: basePath,
+	}
+
+	return &out
+}
+
+// Insert inserts the grammar, its tokens, lines and elements, skipping the ones that are already stored
+func (app *service) Insert(grammar grammars.Grammar) error {
+	keyname := grammar.Hash().String()
+	isStored, err := exists(app.basePath, grammarsDirectory, keyname)
+	if err != nil || isStored {
+		return err
+	}
+
+	// the children are written before their parent, so that a stored record always has its children stored:
+	err = app.InsertToken(grammar.Root())
+	if err != nil {
+		return err
+	}
+
+	record := grammarRecord{
+		Root:     grammar.Root().Hash().String(),
+		Channels: []channelRecord{},
+	}
+
+	if grammar.HasChannels() {
+		for _, oneChannel := range grammar.Channels() {
+			channel, err := app.channel(oneChannel)
+			if err != nil {
+				return err
+			}
+
+			record.Channels = append(record.Channels, channel)
+		}
+	}
+
+	return write(app.basePath, grammarsDirectory, keyname, record)
+}
+
+// InsertToken inserts the token, its lines and elements, skipping the ones that are already stored
+func (app *service) InsertToken(token grammars.Token) error {
+	keyname := token.Hash().String()
+	isStored, err := exists(app.basePath, tokensDirectory, keyname)
+	if err != nil || isStored {
+		return err
+	}
+
+	record := tokenRecord{
+		Lines:  []string{},
+		Suites: []suiteRecord{},
+	}
+
+	if token.HasName() {
+		record.Name = token.Name()
+	}
+
+	for _, oneLine := range token.Lines() {
+		err := app.line(oneLine)
+		if err != nil {
+			return err
+		}
+
+		record.Lines = append(record.Lines, oneLine.Hash().String())
+	}
+
+	if token.HasSuites() {
+		for _, oneSuite := range token.Suites() {
+			record.Suites = append(record.Suites, suiteRecord{
+				IsValid: oneSuite.IsValid(),
+				Content: oneSuite.Content(),
+			})
+		}
+	}
+
+	return write(app.basePath, tokensDirectory, keyname, record)
+}
+
+func (app *service) channel(channel grammars.Channel) (channelRecord, error) {
+	token := channel.Token()
+	err := app.InsertToken(token)
+	if err != nil {
+		return channelRecord{}, err
+	}
+
+	record := channelRecord{
+		Token: token.Hash().String(),
+	}
+
+	if !channel.HasCondition() {
+		return record, nil
+	}
+
+	condition := channel.Condition()
+	if condition.HasPrevious() {
+		err := app.InsertToken(condition.Previous())
+		if err != nil {
+			return channelRecord{}, err
+		}
+
+		record.Previous = condition.Previous().Hash().String()
+	}
+
+	if condition.HasNext() {
+		err := app.InsertToken(condition.Next())
+		if err != nil {
+			return channelRecord{}, err
+		}
+
+		record.Next = condition.Next().Hash().String()
+	}
+
+	return record, nil
+}
+
+func (app *service) line(line grammars.Line) error {
+	keyname := line.Hash().String()
+	isStored, err := exists(app.basePath, linesDirectory, keyname)
+	if err != nil || isStored {
+		return err
+	}
+
+	record := lineRecord{
+		Elements: []string{},
+	}
+
+	for _, oneElement := range line.Elements() {
+		err := app.element(oneElement)
+		if err != nil {
+			return err
+		}
+
+		record.Elements = append(record.Elements, oneElement.Hash().String())
+	}
+
+	return write(app.basePath, linesDirectory, keyname, record)
+}
+
+func (app *service) element(element grammars.Element) error {
+	keyname := element.Hash().String()
+	isStored, err := exists(app.basePath, elementsDirectory, keyname)
+	if err != nil || isStored {
+		return err
+	}
+
+	cardinality := element.Cardinality()
+	record := elementRecord{
+		Min: cardinality.Min(),
+	}
+
+	if cardinality.HasMax() {
+		record.Max = cardinality.Max()
+	}
+
+	content := element.Content()
+	if content.IsValue() {
+		record.Value = content.Value()
+	}
+
+	if content.IsRecursive() {
+		record.Recursive = content.Recursive()
+	}
+
+	if content.IsGrammar() {
+		grammar := content.Grammar()
+		err := app.Insert(grammar)
+		if err != nil {
+			return err
+		}
+
+		record.Grammar = grammar.Hash().String()
+	}
+
+	if content.IsInstance() {
+		err := app.instance(&record, content.Instance())
+		if err != nil {
+			return err
+		}
+	}
+
+	return write(app.basePath, elementsDirectory, keyname, record)
+}
+
+func (app *service) instance(pRecord *elementRecord, instance grammars.Instance) error {
+	if instance.IsToken() {
+		token := instance.Token()
+		err := app.InsertToken(token)
+		if err != nil {
+			return err
+		}
+
+		pRecord.Token = token.Hash().String()
+		return nil
+	}
+
+	everything := instance.Everything()
+	err := app.InsertToken(everything.Exception())
+	if err != nil {
+		return err
+	}
+
+	pRecord.Everything = &everythingRecord{
+		Exception: everything.Exception().Hash().String(),
+	}
+
+	if everything.HasEscape() {
+		err := app.InsertToken(everything.Escape())
+		if err != nil {
+			return err
+		}
+
+		pRecord.Everything.Escape = everything.Escape().Hash().String()
+	}
+
+	return nil
+}
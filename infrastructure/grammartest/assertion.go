@@ -0,0 +1,141 @@
+package grammartest
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/steve-care-software/grammars/applications"
+	"github.com/steve-care-software/grammars/domain/references"
+	"github.com/steve-care-software/grammars/domain/references/coverages"
+)
+
+type assertion struct {
+	t           T
+	application applications.Application
+	isPassed    bool
+}
+
+func createAssertion(
+	t T,
+	application applications.Application,
+) *assertion {
+	out := assertion{
+		t:           t,
+		application: application,
+		isPassed:    true,
+	}
+
+	return &out
+}
+
+func (app *assertion) coverages(reference references.Reference, options Options) bool {
+	app.t.Helper()
+	coverages, err := app.application.Coverages(reference)
+	if err != nil {
+		app.errorf("the coverages could not be executed: %s", err.Error())
+		return app.isPassed
+	}
+
+	if coverages == nil {
+		if options != nil && (options.HasTokens() || options.HasLines()) {
+			app.errorf("the grammar was expected to contain suites in order to enforce its coverage thresholds")
+		}
+
+		return app.isPassed
+	}
+
+	for _, oneCoverage := range coverages.List() {
+		app.executions(oneCoverage)
+	}
+
+	if options == nil || !coverages.HasTokens() {
+		return app.isPassed
+	}
+
+	tokens := coverages.Tokens()
+	if options.HasTokens() {
+		app.tokens(tokens, *options.Tokens())
+	}
+
+	if options.HasLines() {
+		app.lines(tokens, *options.Lines())
+	}
+
+	return app.isPassed
+}
+
+func (app *assertion) executions(coverage coverages.Coverage) {
+	app.t.Helper()
+	name := coverage.Token().Name()
+	for _, oneExecution := range coverage.Executions().List() {
+		if oneExecution.Passed() {
+			continue
+		}
+
+		expectation := oneExecution.Expectation()
+		result := oneExecution.Result()
+		if expectation.IsValid() && result.IsTree() {
+			app.errorf("the token (name: %s) suite (content: %q) was expected to be valid, but contains remaining data: %q", name, expectation.Content(), result.Tree().Remaining())
+			continue
+		}
+
+		if expectation.IsValid() {
+			app.errorf("the token (name: %s) suite (content: %q) was expected to be valid, but contains an error: %s", name, expectation.Content(), result.Error())
+			continue
+		}
+
+		app.errorf("the token (name: %s) suite (content: %q) was expected to be invalid, found: %q", name, expectation.Content(), result.Tree().Bytes(true))
+	}
+}
+
+func (app *assertion) tokens(tokens []coverages.Token, threshold float64) {
+	app.t.Helper()
+	uncovered := []string{}
+	for _, oneToken := range tokens {
+		if oneToken.LinesPercentage() > 0 {
+			continue
+		}
+
+		uncovered = append(uncovered, oneToken.Reference().Name())
+	}
+
+	percentage := percentage(len(tokens)-len(uncovered), len(tokens))
+	if percentage < threshold {
+		app.errorf("the tokens coverage (%.2f%%) was expected to be at least %.2f%%, the uncovered tokens are: %s", percentage, threshold, strings.Join(uncovered, ", "))
+	}
+}
+
+func (app *assertion) lines(tokens []coverages.Token, threshold float64) {
+	app.t.Helper()
+	uncovered := []string{}
+	amount := 0
+	for _, oneToken := range tokens {
+		for _, oneLine := range oneToken.Lines() {
+			amount++
+			if oneLine.IsCovered() {
+				continue
+			}
+
+			uncovered = append(uncovered, fmt.Sprintf("%s (line: %d)", oneToken.Reference().Name(), oneLine.Index()))
+		}
+	}
+
+	percentage := percentage(amount-len(uncovered), amount)
+	if percentage < threshold {
+		app.errorf("the lines coverage (%.2f%%) was expected to be at least %.2f%%, the uncovered lines are: %s", percentage, threshold, strings.Join(uncovered, ", "))
+	}
+}
+
+func (app *assertion) errorf(format string, args ...interface{}) {
+	app.t.Helper()
+	app.t.Errorf(format, args...)
+	app.isPassed = false
+}
+
+func percentage(covered int, amount int) float64 {
+	if amount <= 0 {
+		return 100
+	}
+
+	return float64(covered) * 100 / float64(amount)
+}
@@ -0,0 +1,87 @@
+package grammartest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/steve-care-software/grammars/infrastructure/scripts"
+)
+
+type recorder struct {
+	messages []string
+}
+
+func (obj *recorder) Helper() {
+}
+
+func (obj *recorder) Errorf(format string, args ...interface{}) {
+	obj.messages = append(obj.messages, fmt.Sprintf(format, args...))
+}
+
+func TestAssertCoverages_Success(t *testing.T) {
+	script := `
+		@expression;
+
+		expression: term plusTerm*
+			---
+			valid: onePlusTwo & two & twoPlus;
+			invalid: plus & one;
+		;
+
+		plusTerm: plus term;
+		term: number | parenthesis;
+		parenthesis: open expression close;
+		number: one | two;
+
+		one: 49;
+		two: 50;
+		plus: 43;
+		open: 40;
+		close: 41;
+		onePlusTwo: one plus two;
+		twoPlus: two plus;
+	`
+
+	reference, err := scripts.NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	options, err := NewOptionsBuilder().Create().WithTokens(100).WithLines(50).IsStrict().Now()
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	rec := &recorder{}
+	if AssertCoverages(rec, reference, options) {
+		t.Errorf("the assertions were expected to fail")
+		return
+	}
+
+	expected := []string{
+		`the token (name: expression) suite (content: "2+") was expected to be valid`,
+		`the token (name: expression) suite (content: "1") was expected to be invalid, found: "1"`,
+		`the tokens coverage (80.00%) was expected to be at least 100.00%, the uncovered tokens are: parenthesis`,
+	}
+
+	if len(rec.messages) != len(expected) {
+		t.Errorf("%d messages were expected, %d returned: %v", len(expected), len(rec.messages), rec.messages)
+		return
+	}
+
+	for idx, oneMessage := range expected {
+		if !strings.HasPrefix(rec.messages[idx], oneMessage) {
+			t.Errorf("the message (index: %d) was expected to start with: \n%s\nreturned: \n%s", idx, oneMessage, rec.messages[idx])
+			return
+		}
+	}
+
+	_, err = NewOptionsBuilder().Create().WithLines(101).Now()
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
@@ -0,0 +1,46 @@
+package grammartest
+
+type options struct {
+	pTokens  *float64
+	pLines   *float64
+	isStrict bool
+}
+
+func createOptions(
+	pTokens *float64,
+	pLines *float64,
+	isStrict bool,
+) Options {
+	out := options{
+		pTokens:  pTokens,
+		pLines:   pLines,
+		isStrict: isStrict,
+	}
+
+	return &out
+}
+
+// HasTokens returns true if there is a tokens threshold, false otherwise
+func (obj *options) HasTokens() bool {
+	return obj.pTokens != nil
+}
+
+// Tokens returns the minimum percentage of exercised tokens, if any
+func (obj *options) Tokens() *float64 {
+	return obj.pTokens
+}
+
+// HasLines returns true if there is a lines threshold, false otherwise
+func (obj *options) HasLines() bool {
+	return obj.pLines != nil
+}
+
+// Lines returns the minimum percentage of exercised lines, if any
+func (obj *options) Lines() *float64 {
+	return obj.pLines
+}
+
+// IsStrict returns true if the valid suites must consume their whole content, false otherwise
+func (obj *options) IsStrict() bool {
+	return obj.isStrict
+}
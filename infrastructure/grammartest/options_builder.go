@@ -0,0 +1,70 @@
+package grammartest
+
+import (
+	"errors"
+	"fmt"
+)
+
+type optionsBuilder struct {
+	pTokens  *float64
+	pLines   *float64
+	isStrict bool
+}
+
+func createOptionsBuilder() OptionsBuilder {
+	out := optionsBuilder{
+		pTokens:  nil,
+		pLines:   nil,
+		isStrict: false,
+	}
+
+	return &out
+}
+
+// Create initializes the builder
+func (app *optionsBuilder) Create() OptionsBuilder {
+	return createOptionsBuilder()
+}
+
+// WithTokens adds a tokens threshold to the builder
+func (app *optionsBuilder) WithTokens(tokens float64) OptionsBuilder {
+	app.pTokens = &tokens
+	return app
+}
+
+// WithLines adds a lines threshold to the builder
+func (app *optionsBuilder) WithLines(lines float64) OptionsBuilder {
+	app.pLines = &lines
+	return app
+}
+
+// IsStrict flags the builder as strict
+func (app *optionsBuilder) IsStrict() OptionsBuilder {
+	app.isStrict = true
+	return app
+}
+
+// Now builds a new Options instance
+func (app *optionsBuilder) Now() (Options, error) {
+	thresholds := map[string]*float64{
+		"tokens": app.pTokens,
+		"lines":  app.pLines,
+	}
+
+	for name, pThreshold := range thresholds {
+		if pThreshold == nil {
+			continue
+		}
+
+		if *pThreshold < 0 || *pThreshold > 100 {
+			str := fmt.Sprintf("the %s threshold (%f) must be a percentage between 0 and 100", name, *pThreshold)
+			return nil, errors.New(str)
+		}
+	}
+
+	if app.pTokens == nil && app.pLines == nil && !app.isStrict {
+		return nil, errors.New("there must be at least a tokens threshold, a lines threshold or the strict flag in order to build an Options instance")
+	}
+
+	return createOptions(app.pTokens, app.pLines, app.isStrict), nil
+}
@@ -0,0 +1,53 @@
+package grammartest
+
+import (
+	"github.com/steve-care-software/grammars/applications"
+	"github.com/steve-care-software/grammars/domain/references"
+)
+
+// NewOptionsBuilder creates a new options builder
+func NewOptionsBuilder() OptionsBuilder {
+	return createOptionsBuilder()
+}
+
+// AssertCoverages executes the suites of the reference, reports each suite that does not match its expectation
+// and enforces the minimum coverages of the options, if any. It returns true if all the assertions passed
+func AssertCoverages(t T, reference references.Reference, options Options) bool {
+	t.Helper()
+	builder := applications.NewBuilder().Create()
+	if options != nil && options.IsStrict() {
+		builder.IsStrict()
+	}
+
+	application, err := builder.Now()
+	if err != nil {
+		t.Errorf("the application could not be built: %s", err.Error())
+		return false
+	}
+
+	return createAssertion(t, application).coverages(reference, options)
+}
+
+// T represents the part of testing.TB used by the assertions
+type T interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// OptionsBuilder represents an options builder
+type OptionsBuilder interface {
+	Create() OptionsBuilder
+	WithTokens(tokens float64) OptionsBuilder
+	WithLines(lines float64) OptionsBuilder
+	IsStrict() OptionsBuilder
+	Now() (Options, error)
+}
+
+// Options represents the assertion options
+type Options interface {
+	HasTokens() bool
+	Tokens() *float64
+	HasLines() bool
+	Lines() *float64
+	IsStrict() bool
+}
@@ -0,0 +1,331 @@
+package scripts
+
+import (
+	"fmt"
+	"strings"
+
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/references"
+)
+
+type compilation struct {
+	root        string
+	channels    []channelDeclaration
+	values      map[string]byte
+	composes    map[string]composeAssignment
+	everythings map[string]everythingAssignment
+	tokens      map[string]tokenAssignment
+	order       []string
+	leadings    map[string][]string
+	trailings   map[string][]string
+	footers     []string
+	built       map[string]grammars.Token
+	references  []references.Token
+	referenced  map[string]bool
+}
+
+type channelDeclaration struct {
+	name     string
+	previous string
+	next     string
+}
+
+type composeAssignment struct {
+	elements []composeElement
+	suites   []suiteDeclaration
+}
+
+type composeElement struct {
+	name   string
+	amount uint
+}
+
+type everythingAssignment struct {
+	exception string
+	escape    string
+	suites    []suiteDeclaration
+}
+
+type tokenAssignment struct {
+	lines  [][]lineElement
+	suites []suiteDeclaration
+}
+
+type lineElement struct {
+	name       string
+	min        uint
+	pMax       *uint
+	isExternal bool
+}
+
+type suiteDeclaration struct {
+	isValid bool
+	names   []string
+}
+
+func createCompilation() *compilation {
+	out := compilation{
+		root:        "",
+		channels:    []channelDeclaration{},
+		values:      map[string]byte{},
+		composes:    map[string]composeAssignment{},
+		everythings: map[string]everythingAssignment{},
+		tokens:      map[string]tokenAssignment{},
+		order:       []string{},
+		leadings:    map[string][]string{},
+		trailings:   map[string][]string{},
+		footers:     []string{},
+		built:       map[string]grammars.Token{},
+		references:  []references.Token{},
+		referenced:  map[string]bool{},
+	}
+
+	return &out
+}
+
+// isDeclared returns true if the name is assigned by an instruction, false otherwise
+func (obj *compilation) isDeclared(name string) bool {
+	if _, ok := obj.values[name]; ok {
+		return true
+	}
+
+	if _, ok := obj.composes[name]; ok {
+		return true
+	}
+
+	if _, ok := obj.everythings[name]; ok {
+		return true
+	}
+
+	if _, ok := obj.tokens[name]; ok {
+		return true
+	}
+
+	return false
+}
+
+// isBytes returns true if the name can be converted to bytes without a token, false otherwise
+func (obj *compilation) isBytes(name string, stack []string) bool {
+	if _, ok := obj.values[name]; ok {
+		return true
+	}
+
+	compose, ok := obj.composes[name]
+	if !ok {
+		return false
+	}
+
+	for _, oneName := range stack {
+		if oneName == name {
+			return false
+		}
+	}
+
+	for _, oneElement := range compose.elements {
+		if !obj.isBytes(oneElement.name, append(stack, name)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// script returns the canonical script of the compilation
+func (obj *compilation) script() []byte {
+	root := fmt.Sprintf("%s%s%s", rootPrefix, obj.root, rootSuffix)
+	sections := []string{
+		fmt.Sprintf("%s\n", obj.commentedScript(rootPrefix, root)),
+	}
+
+	if len(obj.channels) > 0 {
+		channels := ""
+		for _, oneChannel := range obj.channels {
+			channel := obj.channelScript(oneChannel)
+			keyname := fmt.Sprintf("%s%s", channelPrefix, oneChannel.name)
+			channels = fmt.Sprintf("%s%s\n", channels, obj.commentedScript(keyname, channel))
+		}
+
+		sections = append(sections, channels)
+	}
+
+	instructions := ""
+	isPrevMultiLine := false
+	for idx, oneName := range obj.order {
+		instruction := obj.commentedScript(oneName, obj.instructionScript(oneName))
+		isMultiLine := strings.Contains(instruction, "\n")
+		if idx > 0 && (isMultiLine || isPrevMultiLine) {
+			instructions = fmt.Sprintf("%s\n", instructions)
+		}
+
+		instructions = fmt.Sprintf("%s%s\n", instructions, instruction)
+		isPrevMultiLine = isMultiLine
+	}
+
+	if instructions != "" {
+		sections = append(sections, instructions)
+	}
+
+	if len(obj.footers) > 0 {
+		sections = append(sections, fmt.Sprintf("%s\n", strings.Join(obj.footers, "\n")))
+	}
+
+	return []byte(strings.Join(sections, "\n"))
+}
+
+func (obj *compilation) commentedScript(keyname string, script string) string {
+	if trailings, ok := obj.trailings[keyname]; ok {
+		script = fmt.Sprintf("%s %s", script, strings.Join(trailings, " "))
+	}
+
+	if leadings, ok := obj.leadings[keyname]; ok {
+		script = fmt.Sprintf("%s\n%s", strings.Join(leadings, "\n"), script)
+	}
+
+	return script
+}
+
+// indentedScript returns the commented script of a keyname, indented inside of a block
+func (obj *compilation) indentedScript(keyname string, script string) string {
+	lines := strings.Split(obj.commentedScript(keyname, script), "\n")
+	return fmt.Sprintf("\t%s", strings.Join(lines, "\n\t"))
+}
+
+func (obj *compilation) channelScript(channel channelDeclaration) string {
+	condition := ""
+	if channel.previous != "" || channel.next != "" {
+		inside := channel.previous
+		if channel.next != "" {
+			inside = fmt.Sprintf("%s%s%s", inside, channelPrevNextDelimiter, channel.next)
+		}
+
+		condition = fmt.Sprintf(" %s%s%s", channelPrevNextPrefix, inside, channelPrevNextSuffix)
+	}
+
+	return fmt.Sprintf("%s%s%s%s", channelPrefix, channel.name, condition, channelSuffix)
+}
+
+func (obj *compilation) instructionScript(name string) string {
+	prefix := fmt.Sprintf("%s%s ", name, assignmentSign)
+	if value, ok := obj.values[name]; ok {
+		return fmt.Sprintf("%s%d%s", prefix, value, instructionSuffix)
+	}
+
+	if compose, ok := obj.composes[name]; ok {
+		elements := []string{}
+		for _, oneElement := range compose.elements {
+			if oneElement.amount == 1 {
+				elements = append(elements, oneElement.name)
+				continue
+			}
+
+			elements = append(elements, fmt.Sprintf("%s%s%d", oneElement.name, amountSeparator, oneElement.amount))
+		}
+
+		return obj.blockScript(name, prefix, []string{strings.Join(elements, " ")}, compose.suites)
+	}
+
+	if everything, ok := obj.everythings[name]; ok {
+		content := fmt.Sprintf("%s%s", everythingPrefixSign, everything.exception)
+		if everything.escape != "" {
+			content = fmt.Sprintf("%s%s%s", content, everythingEscapePrefixSign, everything.escape)
+		}
+
+		return obj.blockScript(name, prefix, []string{content}, everything.suites)
+	}
+
+	token := obj.tokens[name]
+	lines := []string{}
+	for _, oneLine := range token.lines {
+		elements := []string{}
+		for _, oneElement := range oneLine {
+			name := oneElement.name
+			if oneElement.isExternal {
+				name = fmt.Sprintf("%s%s%s", externalTokenPrefix, name, externalTokenSuffix)
+			}
+
+			elements = append(elements, fmt.Sprintf("%s%s", name, cardinalityScript(oneElement.min, oneElement.pMax)))
+		}
+
+		lines = append(lines, strings.Join(elements, " "))
+	}
+
+	return obj.blockScript(name, prefix, lines, token.suites)
+}
+
+func (obj *compilation) blockScript(name string, prefix string, lines []string, suites []suiteDeclaration) string {
+	// the comments of the first line surround the instruction:
+	firstLine := lineKeyname(name, 0)
+	if len(lines) <= 1 && len(suites) <= 0 {
+		return obj.commentedScript(firstLine, fmt.Sprintf("%s%s%s", prefix, strings.Join(lines, ""), instructionSuffix))
+	}
+
+	output := obj.commentedScript(firstLine, fmt.Sprintf("%s%s", prefix, lines[0]))
+	for idx, oneLine := range lines[1:] {
+		line := fmt.Sprintf("%s %s", lineDelimiter, oneLine)
+		output = fmt.Sprintf("%s\n%s", output, obj.indentedScript(lineKeyname(name, idx+1), line))
+	}
+
+	if len(suites) > 0 {
+		output = fmt.Sprintf("%s\n%s", output, obj.indentedScript(suitePrefixKeyname(name), suitePrefix))
+		for idx, oneSuite := range suites {
+			suiteName := invalidSuiteName
+			if oneSuite.isValid {
+				suiteName = validSuiteName
+			}
+
+			padding := strings.Repeat(" ", len(suiteName))
+			suite := fmt.Sprintf("%s%s %s", suiteName, assignmentSign, oneSuite.names[0])
+			for _, oneName := range oneSuite.names[1:] {
+				suite = fmt.Sprintf("%s\n%s%s %s", suite, padding, suiteDelimiter, oneName)
+			}
+
+			suite = fmt.Sprintf("%s%s", suite, suiteSuffix)
+			output = fmt.Sprintf("%s\n%s", output, obj.indentedScript(suiteKeyname(name, idx), suite))
+		}
+	}
+
+	return fmt.Sprintf("%s\n%s", output, instructionSuffix)
+}
+
+// lineKeyname returns the keyname of the comments of a line of an instruction
+func lineKeyname(name string, index int) string {
+	return fmt.Sprintf("%s%s%d", name, lineDelimiter, index)
+}
+
+// suitePrefixKeyname returns the keyname of the comments of the suite prefix of an instruction
+func suitePrefixKeyname(name string) string {
+	return fmt.Sprintf("%s%s", name, suitePrefix)
+}
+
+// suiteKeyname returns the keyname of the comments of a suite of an instruction
+func suiteKeyname(name string, index int) string {
+	return fmt.Sprintf("%s%s%d", name, suiteDelimiter, index)
+}
+
+func cardinalityScript(min uint, pMax *uint) string {
+	if pMax == nil {
+		switch min {
+		case 0:
+			return cardinalityMultipleOptional
+		case 1:
+			return cardinalityMultipleMandatory
+		}
+
+		return fmt.Sprintf("%s%d%s%s", cardinalityPrefix, min, cardinalitySeparator, cardinalitySuffix)
+	}
+
+	max := *pMax
+	if min == max {
+		if min == 1 {
+			return ""
+		}
+
+		return fmt.Sprintf("%s%d%s", cardinalityPrefix, min, cardinalitySuffix)
+	}
+
+	if min == 0 && max == 1 {
+		return cardinalitySingleOptional
+	}
+
+	return fmt.Sprintf("%s%d%s%d%s", cardinalityPrefix, min, cardinalitySeparator, max, cardinalitySuffix)
+}
@@ -0,0 +1,828 @@
+package scripts
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/steve-care-software/grammars/applications"
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/references"
+	"github.com/steve-care-software/grammars/domain/trees"
+)
+
+type compiler struct {
+	application             applications.Application
+	grammar                 references.Reference
+	builder                 grammars.Builder
+	channelBuilder          grammars.ChannelBuilder
+	channelConditionBuilder grammars.ChannelConditionBuilder
+	tokenBuilder            grammars.TokenBuilder
+	suiteBuilder            grammars.SuiteBuilder
+	lineBuilder             grammars.LineBuilder
+	elementBuilder          grammars.ElementBuilder
+	instanceBuilder         grammars.InstanceBuilder
+	everythingBuilder       grammars.EverythingBuilder
+	cardinalityBuilder      grammars.CardinalityBuilder
+	refBuilder              references.Builder
+	refTokensBuilder        references.TokensBuilder
+	refTokenBuilder         references.TokenBuilder
+}
+
+func createCompiler(
+	application applications.Application,
+	grammar references.Reference,
+	builder grammars.Builder,
+	channelBuilder grammars.ChannelBuilder,
+	channelConditionBuilder grammars.ChannelConditionBuilder,
+	tokenBuilder grammars.TokenBuilder,
+	suiteBuilder grammars.SuiteBuilder,
+	lineBuilder grammars.LineBuilder,
+	elementBuilder grammars.ElementBuilder,
+	instanceBuilder grammars.InstanceBuilder,
+	everythingBuilder grammars.EverythingBuilder,
+	cardinalityBuilder grammars.CardinalityBuilder,
+	refBuilder references.Builder,
+	refTokensBuilder references.TokensBuilder,
+	refTokenBuilder references.TokenBuilder,
+) *compiler {
+	out := compiler{
+		application:             application,
+		grammar:                 grammar,
+		builder:                 builder,
+		channelBuilder:          channelBuilder,
+		channelConditionBuilder: channelConditionBuilder,
+		tokenBuilder:            tokenBuilder,
+		suiteBuilder:            suiteBuilder,
+		lineBuilder:             lineBuilder,
+		elementBuilder:          elementBuilder,
+		instanceBuilder:         instanceBuilder,
+		everythingBuilder:       everythingBuilder,
+		cardinalityBuilder:      cardinalityBuilder,
+		refBuilder:              refBuilder,
+		refTokensBuilder:        refTokensBuilder,
+		refTokenBuilder:         refTokenBuilder,
+	}
+
+	return &out
+}
+
+// Compile compiles a script to a grammar reference
+func (app *compiler) Compile(script []byte) (references.Reference, error) {
+	_, compilation, err := app.compilation(script)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := app.token(compilation, compilation.root, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.builder.Create().WithRoot(root)
+	if len(compilation.channels) > 0 {
+		channels := []grammars.Channel{}
+		for _, oneDeclaration := range compilation.channels {
+			channel, err := app.channel(compilation, oneDeclaration)
+			if err != nil {
+				return nil, err
+			}
+
+			channels = append(channels, channel)
+		}
+
+		builder.WithChannels(channels)
+	}
+
+	grammar, err := builder.Now()
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := app.refTokensBuilder.Create().WithList(compilation.references).Now()
+	if err != nil {
+		return nil, err
+	}
+
+	return app.refBuilder.Create().WithRoot(grammar).WithTokens(tokens).Now()
+}
+
+func (app *compiler) compilation(script []byte) (trees.Tree, *compilation, error) {
+	tree, err := app.application.Execute(app.grammar.Root(), script)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if tree.HasRemaining() {
+		str := fmt.Sprintf("the script could not be entirely parsed, remaining: %s", tree.Remaining())
+		return nil, nil, errors.New(str)
+	}
+
+	compilation := createCompilation()
+	err = app.declarations(tree, compilation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tree, compilation, nil
+}
+
+func (app *compiler) declarations(tree trees.Tree, compilation *compilation) error {
+	for _, oneTree := range app.children(tree) {
+		name := oneTree.Grammar().Name()
+		switch name {
+		case rootTokenName:
+			compilation.root = app.text(app.fetch(oneTree, variableNameTokenName)[0])
+		case channelTokenName:
+			channel := app.channelDeclaration(oneTree)
+			compilation.channels = append(compilation.channels, channel)
+		case instructionTokenName:
+			err := app.instruction(app.children(oneTree)[0], compilation)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (app *compiler) channelDeclaration(tree trees.Tree) channelDeclaration {
+	out := channelDeclaration{
+		name: app.text(app.fetch(tree, variableNameTokenName)[0]),
+	}
+
+	insides := app.fetchAll(tree, channelPreviousNextInsideTokenName)
+	if len(insides) <= 0 {
+		return out
+	}
+
+	inside := insides[0]
+	names := app.fetch(inside, variableNameTokenName)
+	switch inside.Token().Successful().Index() {
+	case 0:
+		out.previous = app.text(names[0])
+		out.next = app.text(names[1])
+	case 1:
+		out.next = app.text(names[0])
+	default:
+		out.previous = app.text(names[0])
+	}
+
+	return out
+}
+
+func (app *compiler) instruction(tree trees.Tree, compilation *compilation) error {
+	name := app.text(app.fetch(tree, variableNameTokenName)[0])
+	if compilation.isDeclared(name) {
+		str := fmt.Sprintf("the name (%s) is declared more than once", name)
+		return errors.New(str)
+	}
+
+	suites := []suiteDeclaration{}
+	for _, oneSuite := range app.fetch(tree, suiteTokenName) {
+		suites = append(suites, app.suites(oneSuite)...)
+	}
+
+	// the suite sections that follow the instruction add their suites to it:
+	for _, oneSection := range app.fetch(tree, suiteSectionTokenName) {
+		suites = append(suites, app.suites(app.fetch(oneSection, suiteTokenName)[0])...)
+	}
+
+	compilation.order = append(compilation.order, name)
+	switch tree.Grammar().Name() {
+	case valueAssignmentTokenName:
+		number := app.text(app.fetch(tree, numberTokenName)[0])
+		value, err := strconv.ParseUint(number, 10, 8)
+		if err != nil {
+			str := fmt.Sprintf("the value (%s) assigned to the name (%s) must be a number between 0 and 255", number, name)
+			return errors.New(str)
+		}
+
+		compilation.values[name] = byte(value)
+	case composeAssignmentTokenName:
+		elements, err := app.composeElements(tree)
+		if err != nil {
+			return err
+		}
+
+		compilation.composes[name] = composeAssignment{
+			elements: elements,
+			suites:   suites,
+		}
+	case everythingAssignmentTokenName:
+		everything := app.children(app.children(tree)[1])[0]
+		names := app.fetch(everything, variableNameTokenName)
+		assignment := everythingAssignment{
+			exception: app.text(names[0]),
+			suites:    suites,
+		}
+
+		if everything.Grammar().Name() == everythingWithEscapeTokenName {
+			assignment.escape = app.text(names[1])
+		}
+
+		compilation.everythings[name] = assignment
+	case tokenAssignmentTokenName:
+		lines, err := app.lines(app.fetch(tree, blockTokenName)[0])
+		if err != nil {
+			return err
+		}
+
+		compilation.tokens[name] = tokenAssignment{
+			lines:  lines,
+			suites: suites,
+		}
+	}
+
+	return nil
+}
+
+func (app *compiler) composeElements(tree trees.Tree) ([]composeElement, error) {
+	output := []composeElement{}
+	for _, oneElement := range app.children(app.children(tree)[1]) {
+		element := composeElement{
+			name:   app.text(app.fetch(oneElement, variableNameTokenName)[0]),
+			amount: 1,
+		}
+
+		for _, oneAmount := range app.fetch(oneElement, composeWithAmountTokenName) {
+			number := app.text(app.fetch(oneAmount, numberTokenName)[0])
+			amount, err := strconv.ParseUint(number, 10, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			if amount <= 0 {
+				str := fmt.Sprintf("the amount of the compose element (%s) must be greater than zero", element.name)
+				return nil, errors.New(str)
+			}
+
+			element.amount = uint(amount)
+		}
+
+		output = append(output, element)
+	}
+
+	return output, nil
+}
+
+func (app *compiler) lineTrees(block trees.Tree) []trees.Tree {
+	output := []trees.Tree{}
+	for _, oneTree := range app.children(block) {
+		if oneTree.Grammar().Name() == delimiterThenLineTokenName {
+			output = append(output, app.children(oneTree)[0])
+			continue
+		}
+
+		output = append(output, oneTree)
+	}
+
+	return output
+}
+
+func (app *compiler) lines(block trees.Tree) ([][]lineElement, error) {
+	output := [][]lineElement{}
+	for _, oneLine := range app.lineTrees(block) {
+		elements := []lineElement{}
+		for _, oneElement := range app.fetch(oneLine, elementTokenName) {
+			max := uint(1)
+			element := lineElement{
+				min:  1,
+				pMax: &max,
+			}
+
+			nameTree := oneElement
+			externals := app.fetch(oneElement, externalTokenName)
+			if len(externals) > 0 {
+				nameTree = externals[0]
+				element.isExternal = true
+			}
+
+			element.name = app.text(app.fetch(nameTree, variableNameTokenName)[0])
+
+			for _, oneCardinality := range app.fetch(oneElement, cardinalityTokenName) {
+				min, pMax, err := app.cardinality(oneCardinality)
+				if err != nil {
+					return nil, err
+				}
+
+				element.min = min
+				element.pMax = pMax
+			}
+
+			elements = append(elements, element)
+		}
+
+		output = append(output, elements)
+	}
+
+	return output, nil
+}
+
+func (app *compiler) cardinality(tree trees.Tree) (uint, *uint, error) {
+	numbers := []uint{}
+	for _, oneNumber := range app.fetch(tree, numberTokenName) {
+		number, err := strconv.ParseUint(app.text(oneNumber), 10, 0)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		numbers = append(numbers, uint(number))
+	}
+
+	one := uint(1)
+	switch tree.Token().Successful().Index() {
+	case 0:
+		return 0, &one, nil
+	case 1:
+		return 1, nil, nil
+	case 2:
+		return 0, nil, nil
+	case 3:
+		return numbers[0], &numbers[0], nil
+	}
+
+	if len(numbers) > 1 {
+		return numbers[0], &numbers[1], nil
+	}
+
+	return numbers[0], nil, nil
+}
+
+func (app *compiler) suites(tree trees.Tree) []suiteDeclaration {
+	output := []suiteDeclaration{}
+	for _, oneTree := range app.children(tree) {
+		name := oneTree.Grammar().Name()
+		if name != suiteValidTokenName && name != suiteInvalidTokenName {
+			continue
+		}
+
+		names := []string{}
+		block := app.fetch(oneTree, suiteBlockTokenName)[0]
+		for _, oneName := range app.fetchAll(block, variableNameTokenName) {
+			names = append(names, app.text(oneName))
+		}
+
+		output = append(output, suiteDeclaration{
+			isValid: name == suiteValidTokenName,
+			names:   names,
+		})
+	}
+
+	return output
+}
+
+func (app *compiler) channel(compilation *compilation, declaration channelDeclaration) (grammars.Channel, error) {
+	token, err := app.token(compilation, declaration.name, []string{})
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.channelBuilder.Create().WithToken(token)
+	if declaration.previous != "" || declaration.next != "" {
+		conditionBuilder := app.channelConditionBuilder.Create()
+		if declaration.previous != "" {
+			previous, err := app.token(compilation, declaration.previous, []string{})
+			if err != nil {
+				return nil, err
+			}
+
+			conditionBuilder.WithPrevious(previous)
+		}
+
+		if declaration.next != "" {
+			next, err := app.token(compilation, declaration.next, []string{})
+			if err != nil {
+				return nil, err
+			}
+
+			conditionBuilder.WithNext(next)
+		}
+
+		condition, err := conditionBuilder.Now()
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithCondition(condition)
+	}
+
+	return builder.Now()
+}
+
+func (app *compiler) token(compilation *compilation, name string, stack []string) (grammars.Token, error) {
+	token, _, err := app.tokenWithFree(compilation, name, stack)
+	return token, err
+}
+
+// tokenWithFree builds a token and returns the names of the enclosing tokens it recursively references
+func (app *compiler) tokenWithFree(compilation *compilation, name string, stack []string) (grammars.Token, map[string]bool, error) {
+	if token, ok := compilation.built[name]; ok {
+		return token, map[string]bool{}, nil
+	}
+
+	for _, oneName := range stack {
+		if oneName == name {
+			str := fmt.Sprintf("the name (%s) cannot be used recursively outside of a line element", name)
+			return nil, nil, errors.New(str)
+		}
+	}
+
+	if !compilation.isDeclared(name) {
+		str := fmt.Sprintf("the name (%s) is not declared", name)
+		return nil, nil, errors.New(str)
+	}
+
+	free := map[string]bool{}
+	currentStack := append(append([]string{}, stack...), name)
+	lines := []grammars.Line{}
+	suites := []suiteDeclaration{}
+	if assignment, ok := compilation.tokens[name]; ok {
+		suites = assignment.suites
+		for _, oneLine := range assignment.lines {
+			elements := []grammars.Element{}
+			for _, oneElement := range oneLine {
+				element, elementFree, err := app.element(compilation, oneElement, currentStack)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				for oneName := range elementFree {
+					free[oneName] = true
+				}
+
+				elements = append(elements, element)
+			}
+
+			line, err := app.lineBuilder.Create().WithElements(elements).Now()
+			if err != nil {
+				return nil, nil, err
+			}
+
+			lines = append(lines, line)
+		}
+	}
+
+	if assignment, ok := compilation.composes[name]; ok {
+		suites = assignment.suites
+		elements := []grammars.Element{}
+		for _, oneElement := range assignment.elements {
+			amount := oneElement.amount
+			element, elementFree, err := app.element(compilation, lineElement{
+				name: oneElement.name,
+				min:  amount,
+				pMax: &amount,
+			}, currentStack)
+
+			if err != nil {
+				return nil, nil, err
+			}
+
+			for oneName := range elementFree {
+				free[oneName] = true
+			}
+
+			elements = append(elements, element)
+		}
+
+		line, err := app.lineBuilder.Create().WithElements(elements).Now()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		lines = append(lines, line)
+	}
+
+	if _, ok := compilation.values[name]; ok {
+		one := uint(1)
+		element, _, err := app.valueElement(compilation, name, 1, &one)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		line, err := app.lineBuilder.Create().WithElements([]grammars.Element{
+			element,
+		}).Now()
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		lines = append(lines, line)
+	}
+
+	if assignment, ok := compilation.everythings[name]; ok {
+		suites = assignment.suites
+		one := uint(1)
+		element, err := app.everythingElement(compilation, assignment, 1, &one, currentStack)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		line, err := app.lineBuilder.Create().WithElements([]grammars.Element{
+			element,
+		}).Now()
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		lines = append(lines, line)
+	}
+
+	builder := app.tokenBuilder.Create().WithName(name).WithLines(lines)
+	if len(suites) > 0 {
+		list, err := app.suiteList(compilation, suites)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		builder.WithSuites(list)
+	}
+
+	token, err := builder.Now()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// a token that recursively references an enclosing token depends on its stack and therefore cannot be reused:
+	delete(free, name)
+	if len(free) <= 0 {
+		compilation.built[name] = token
+	}
+
+	// a recursive token is rebuilt every time it is reached, but only referenced once per hash:
+	keyname := token.Hash().String()
+	if compilation.referenced[keyname] {
+		return token, free, nil
+	}
+
+	reference, err := app.refTokenBuilder.Create().WithName(name).WithReference(token).Now()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	compilation.referenced[keyname] = true
+	compilation.references = append(compilation.references, reference)
+	return token, free, nil
+}
+
+func (app *compiler) element(compilation *compilation, element lineElement, stack []string) (grammars.Element, map[string]bool, error) {
+	cardinality, err := app.cardinalityInstance(element.min, element.pMax)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if element.isExternal {
+		return app.externalElement(compilation, element.name, cardinality, stack)
+	}
+
+	for _, oneName := range stack {
+		if oneName != element.name {
+			continue
+		}
+
+		ins, err := app.elementBuilder.Create().WithCardinality(cardinality).WithRecursive(element.name).Now()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return ins, map[string]bool{
+			element.name: true,
+		}, nil
+	}
+
+	if compose, ok := compilation.composes[element.name]; ok && len(compose.suites) <= 0 && compilation.isBytes(element.name, []string{}) {
+		return app.valueElement(compilation, element.name, element.min, element.pMax)
+	}
+
+	if _, ok := compilation.values[element.name]; ok {
+		return app.valueElement(compilation, element.name, element.min, element.pMax)
+	}
+
+	if everything, ok := compilation.everythings[element.name]; ok && len(everything.suites) <= 0 {
+		ins, err := app.everythingElement(compilation, everything, element.min, element.pMax, stack)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return ins, map[string]bool{}, nil
+	}
+
+	token, free, err := app.tokenWithFree(compilation, element.name, stack)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	instance, err := app.instanceBuilder.Create().WithToken(token).Now()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ins, err := app.elementBuilder.Create().WithCardinality(cardinality).WithInstance(instance).Now()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ins, free, nil
+}
+
+// externalElement wraps the named token in a grammar without channels, so that no channel can be skipped inside of it
+func (app *compiler) externalElement(compilation *compilation, name string, cardinality grammars.Cardinality, stack []string) (grammars.Element, map[string]bool, error) {
+	token, free, err := app.tokenWithFree(compilation, name, stack)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	grammar, err := app.builder.Create().WithRoot(token).Now()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ins, err := app.elementBuilder.Create().WithCardinality(cardinality).WithGrammar(grammar).Now()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ins, free, nil
+}
+
+func (app *compiler) valueElement(compilation *compilation, name string, min uint, pMax *uint) (grammars.Element, map[string]bool, error) {
+	value, err := app.bytes(compilation, name, []string{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cardinality, err := app.cardinalityInstance(min, pMax)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ins, err := app.elementBuilder.Create().WithCardinality(cardinality).WithValue(value).Now()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ins, map[string]bool{}, nil
+}
+
+func (app *compiler) everythingElement(compilation *compilation, assignment everythingAssignment, min uint, pMax *uint, stack []string) (grammars.Element, error) {
+	exception, err := app.token(compilation, assignment.exception, stack)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := app.everythingBuilder.Create().WithException(exception)
+	if assignment.escape != "" {
+		escape, err := app.token(compilation, assignment.escape, stack)
+		if err != nil {
+			return nil, err
+		}
+
+		builder.WithEscape(escape)
+	}
+
+	everything, err := builder.Now()
+	if err != nil {
+		return nil, err
+	}
+
+	instance, err := app.instanceBuilder.Create().WithEverything(everything).Now()
+	if err != nil {
+		return nil, err
+	}
+
+	cardinality, err := app.cardinalityInstance(min, pMax)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.elementBuilder.Create().WithCardinality(cardinality).WithInstance(instance).Now()
+}
+
+func (app *compiler) cardinalityInstance(min uint, pMax *uint) (grammars.Cardinality, error) {
+	builder := app.cardinalityBuilder.Create().WithMin(min)
+	if pMax != nil {
+		builder.WithMax(*pMax)
+	}
+
+	return builder.Now()
+}
+
+func (app *compiler) suiteList(compilation *compilation, declarations []suiteDeclaration) ([]grammars.Suite, error) {
+	output := []grammars.Suite{}
+	for _, oneDeclaration := range declarations {
+		for _, oneName := range oneDeclaration.names {
+			content, err := app.bytes(compilation, oneName, []string{})
+			if err != nil {
+				return nil, err
+			}
+
+			builder := app.suiteBuilder.Create()
+			if oneDeclaration.isValid {
+				builder.WithValid(content)
+			}
+
+			if !oneDeclaration.isValid {
+				builder.WithInvalid(content)
+			}
+
+			suite, err := builder.Now()
+			if err != nil {
+				return nil, err
+			}
+
+			output = append(output, suite)
+		}
+	}
+
+	return output, nil
+}
+
+func (app *compiler) bytes(compilation *compilation, name string, stack []string) ([]byte, error) {
+	if value, ok := compilation.values[name]; ok {
+		return []byte{value}, nil
+	}
+
+	compose, ok := compilation.composes[name]
+	if !ok {
+		str := fmt.Sprintf("the name (%s) was expected to reference a value or a compose", name)
+		return nil, errors.New(str)
+	}
+
+	for _, oneName := range stack {
+		if oneName == name {
+			str := fmt.Sprintf("the compose (%s) cannot contain itself", name)
+			return nil, errors.New(str)
+		}
+	}
+
+	output := []byte{}
+	for _, oneElement := range compose.elements {
+		content, err := app.bytes(compilation, oneElement.name, append(stack, name))
+		if err != nil {
+			return nil, err
+		}
+
+		for i := uint(0); i < oneElement.amount; i++ {
+			output = append(output, content...)
+		}
+	}
+
+	return output, nil
+}
+
+// children returns the trees of the successful line of a tree
+func (app *compiler) children(tree trees.Tree) []trees.Tree {
+	output := []trees.Tree{}
+	if !tree.Token().HasSuccessful() {
+		return output
+	}
+
+	elements := tree.Token().Successful().Elements()
+	for _, oneElement := range elements {
+		for _, oneContent := range oneElement.Contents() {
+			if !oneContent.IsTree() {
+				continue
+			}
+
+			output = append(output, oneContent.Tree())
+		}
+	}
+
+	return output
+}
+
+// fetch returns the children of a tree that are named using the given name
+func (app *compiler) fetch(tree trees.Tree, name string) []trees.Tree {
+	output := []trees.Tree{}
+	for _, oneTree := range app.children(tree) {
+		if oneTree.Grammar().Name() != name {
+			continue
+		}
+
+		output = append(output, oneTree)
+	}
+
+	return output
+}
+
+// fetchAll returns the descendants of a tree that are named using the given name
+func (app *compiler) fetchAll(tree trees.Tree, name string) []trees.Tree {
+	output := []trees.Tree{}
+	for _, oneTree := range app.children(tree) {
+		if oneTree.Grammar().Name() == name {
+			output = append(output, oneTree)
+			continue
+		}
+
+		output = append(output, app.fetchAll(oneTree, name)...)
+	}
+
+	return output
+}
+
+func (app *compiler) text(tree trees.Tree) string {
+	return string(tree.Bytes(false))
+}
@@ -0,0 +1,205 @@
+package scripts
+
+import (
+	"testing"
+
+	ast_applications "github.com/steve-care-software/grammars/applications"
+)
+
+func TestCompiler_Success(t *testing.T) {
+	script := `
+		// this is the root entry point:
+		@expression;
+
+		// the channels:
+		-space;
+		-newLine;
+
+		expression: term plusTerm*
+			---
+			valid	: onePlusTwo
+					& two
+					;
+
+			invalid: plus;
+		;
+
+		plusTerm: plus term;
+		term: number | parenthesis;
+		parenthesis: open expression close;
+		number: digit+;
+		digit: zero | one | two;
+
+		zero: 48;
+		one: 49;
+		two: 50;
+		plus: 43;
+		open: 40;
+		close: 41;
+		space: 32;
+		newLine: 10;
+		onePlusTwo: one plus two;
+	`
+
+	reference, err := NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	grammarApp := ast_applications.NewApplication()
+	validInputs := []string{
+		"1+2",
+		"10 + (2 + 21)",
+		"((1))",
+	}
+
+	for _, oneInput := range validInputs {
+		tree, err := grammarApp.Execute(reference.Root(), []byte(oneInput))
+		if err != nil {
+			t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+			return
+		}
+
+		if tree.HasRemaining() {
+			t.Errorf("the input (%s) was expected to NOT contain remaining data", oneInput)
+			return
+		}
+	}
+
+	_, err = grammarApp.Execute(reference.Root(), []byte("(1"))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+
+	coverages, err := grammarApp.Coverages(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	executionsList := coverages.List()[0].Executions().List()
+	if len(executionsList) != 3 {
+		t.Errorf("%d executions were expected, %d returned", 3, len(executionsList))
+		return
+	}
+
+	for idx, oneExecution := range executionsList {
+		if oneExecution.Expectation().IsValid() != oneExecution.Result().IsTree() {
+			t.Errorf("the execution (index: %d) was not expected to fail", idx)
+			return
+		}
+	}
+}
+
+func TestCompiler_undeclaredName_returnsError(t *testing.T) {
+	script := `
+		@myToken;
+		myToken: myValue undeclared*;
+		myValue: 45;
+	`
+
+	_, err := NewCompiler().Compile([]byte(script))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestCompiler_nameDeclaredTwice_returnsError(t *testing.T) {
+	script := `
+		@myValue;
+		myValue: 45;
+		myValue: 46;
+	`
+
+	_, err := NewCompiler().Compile([]byte(script))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestCompiler_invalidValue_returnsError(t *testing.T) {
+	script := `
+		@myValue;
+		myValue: 256;
+	`
+
+	_, err := NewCompiler().Compile([]byte(script))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
+
+func TestCompiler_withSuiteSections_Success(t *testing.T) {
+	script := `
+		@myCompose;
+		myCompose: one two
+			---
+			valid: oneTwo;
+		;
+
+		---
+			invalid	: one
+					& two
+					;
+		;
+
+		oneTwo: one two;
+		one: 49;
+		two: 50;
+	`
+
+	reference, err := NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	root := reference.Root().Root()
+	if !root.HasSuites() {
+		t.Errorf("the root token was expected to contain suites")
+		return
+	}
+
+	suitesList := root.Suites()
+	if len(suitesList) != 3 {
+		t.Errorf("%d suites were expected, %d returned", 3, len(suitesList))
+		return
+	}
+}
+
+func TestCompiler_withRecursiveTokens_referencesEachTokenOnce(t *testing.T) {
+	script := `
+		@expression;
+		expression: term plusTerm*;
+		plusTerm: plus term;
+		term: number | parenthesis;
+		parenthesis: open expression close;
+		number: one+;
+		one: 49;
+		plus: 43;
+		open: 40;
+		close: 41;
+	`
+
+	reference, err := NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	found := map[string]bool{}
+	for _, oneToken := range reference.Tokens().List() {
+		keyname := oneToken.Reference().Hash().String()
+		if found[keyname] {
+			t.Errorf("the token (%s) was expected to be referenced once", oneToken.Name())
+			return
+		}
+
+		found[keyname] = true
+	}
+}
@@ -75,8 +75,7 @@ func (app *channel) Channels() ([]grammars.Channel, []references.Token) {
 				}),
 			},
 			app.suite.Suites(map[string]bool{
-				`// this is a comment
-				`: true,
+				"// this is a comment": true,
 			}),
 		),
 	)
@@ -70,6 +70,20 @@ func (app *element) FromToken(token grammars.Token, cardinality grammars.Cardina
 	return element
 }
 
+// FromGrammar returns an element from an external grammar
+func (app *element) FromGrammar(grammar grammars.Grammar, cardinality grammars.Cardinality) grammars.Element {
+	element, err := app.elementBuilder.Create().
+		WithGrammar(grammar).
+		WithCardinality(cardinality).
+		Now()
+
+	if err != nil {
+		panic(err)
+	}
+
+	return element
+}
+
 // FromValue creates an element from value
 func (app *element) FromValue(value []byte) grammars.Element {
 	ins, err := app.elementBuilder.Create().
@@ -21,5 +21,6 @@ func NewElement() Element {
 type Element interface {
 	FromEverything(everything grammars.Everything) grammars.Element
 	FromToken(token grammars.Token, cardinality grammars.Cardinality) grammars.Element
+	FromGrammar(grammar grammars.Grammar, cardinality grammars.Cardinality) grammars.Element
 	FromValue(value []byte) grammars.Element
 }
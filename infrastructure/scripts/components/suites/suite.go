@@ -1,6 +1,10 @@
 package suites
 
-import grammars "github.com/steve-care-software/grammars/domain"
+import (
+	"sort"
+
+	grammars "github.com/steve-care-software/grammars/domain"
+)
 
 type suite struct {
 	suiteBuilder grammars.SuiteBuilder
@@ -18,9 +22,23 @@ func createSuite(
 
 // Suites creates suites based on the values
 func (app *suite) Suites(values map[string]bool) []grammars.Suite {
+	// sort the keys so that the suites, and therefore the token hashes, are deterministic, the valid suites first like compiled scripts:
+	keys := []string{}
+	for str := range values {
+		keys = append(keys, str)
+	}
+
+	sort.Slice(keys, func(i int, j int) bool {
+		if values[keys[i]] != values[keys[j]] {
+			return values[keys[i]]
+		}
+
+		return keys[i] < keys[j]
+	})
+
 	list := []grammars.Suite{}
-	for str, isValid := range values {
-		suite := app.suite([]byte(str), isValid)
+	for _, str := range keys {
+		suite := app.suite([]byte(str), values[str])
 		list = append(list, suite)
 	}
 
@@ -92,6 +92,7 @@ func (app *token) FromLines(name string, lines []grammars.Line, suites []grammar
 	ref, err := app.tokenBuilder.Create().
 		WithLines(lines).
 		WithSuites(suites).
+		WithName(name).
 		Now()
 
 	if err != nil {
@@ -0,0 +1,549 @@
+package scripts
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	grammars "github.com/steve-care-software/grammars/domain"
+	"github.com/steve-care-software/grammars/domain/references"
+)
+
+var byteNames = map[byte]string{
+	9:   "tab",
+	10:  "newLine",
+	13:  "carriageReturn",
+	32:  "space",
+	33:  "exclamation",
+	34:  "quote",
+	35:  "hash",
+	36:  "dollar",
+	37:  "percent",
+	38:  "ampersand",
+	39:  "apostrophe",
+	40:  "openParenthesis",
+	41:  "closeParenthesis",
+	42:  "asterisk",
+	43:  "plus",
+	44:  "comma",
+	45:  "hyphen",
+	46:  "dot",
+	47:  "slash",
+	58:  "colon",
+	59:  "semicolon",
+	60:  "lessThan",
+	61:  "equal",
+	62:  "greaterThan",
+	63:  "question",
+	64:  "at",
+	91:  "openBracket",
+	92:  "backslash",
+	93:  "closeBracket",
+	94:  "caret",
+	95:  "underscore",
+	96:  "backtick",
+	123: "openBrace",
+	124: "pipe",
+	125: "closeBrace",
+	126: "tilde",
+}
+
+var digitNames = []string{
+	"zero",
+	"one",
+	"two",
+	"three",
+	"four",
+	"five",
+	"six",
+	"seven",
+	"eight",
+	"nine",
+}
+
+type decompiler struct {
+}
+
+type decompilation struct {
+	reference   references.Reference
+	compilation *compilation
+	taken       map[string]bool
+	tokens      []string
+	everythings []string
+	composes    []string
+	values      map[byte]string
+	bytes       map[string]string
+	exceptions  map[string]string
+}
+
+func createDecompiler() Decompiler {
+	out := decompiler{}
+	return &out
+}
+
+// Decompile decompiles a grammar reference to a script
+func (app *decompiler) Decompile(reference references.Reference) ([]byte, error) {
+	compilation := createCompilation()
+	state := &decompilation{
+		reference:   reference,
+		compilation: compilation,
+		taken:       map[string]bool{},
+		tokens:      []string{},
+		everythings: []string{},
+		composes:    []string{},
+		values:      map[byte]string{},
+		bytes:       map[string]string{},
+		exceptions:  map[string]string{},
+	}
+
+	grammar := reference.Root()
+	err := app.names(state, grammar, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := app.token(state, grammar.Root())
+	if err != nil {
+		return nil, err
+	}
+
+	compilation.root = root
+	if grammar.HasChannels() {
+		for _, oneChannel := range grammar.Channels() {
+			declaration, err := app.channel(state, oneChannel)
+			if err != nil {
+				return nil, err
+			}
+
+			compilation.channels = append(compilation.channels, declaration)
+		}
+	}
+
+	values := []int{}
+	for oneValue := range state.values {
+		values = append(values, int(oneValue))
+	}
+
+	sort.Ints(values)
+	compilation.order = append(compilation.order, state.tokens...)
+	compilation.order = append(compilation.order, state.everythings...)
+	compilation.order = append(compilation.order, state.composes...)
+	for _, oneValue := range values {
+		compilation.order = append(compilation.order, state.values[byte(oneValue)])
+	}
+
+	return compilation.script(), nil
+}
+
+func (app *decompiler) names(state *decompilation, grammar grammars.Grammar, visited map[string]bool) error {
+	tokens := []grammars.Token{
+		grammar.Root(),
+	}
+
+	if grammar.HasChannels() {
+		for _, oneChannel := range grammar.Channels() {
+			tokens = append(tokens, oneChannel.Token())
+			if !oneChannel.HasCondition() {
+				continue
+			}
+
+			condition := oneChannel.Condition()
+			if condition.HasPrevious() {
+				tokens = append(tokens, condition.Previous())
+			}
+
+			if condition.HasNext() {
+				tokens = append(tokens, condition.Next())
+			}
+		}
+	}
+
+	for _, oneToken := range tokens {
+		err := app.tokenNames(state, oneToken, visited)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (app *decompiler) tokenNames(state *decompilation, token grammars.Token, visited map[string]bool) error {
+	keyname := token.Hash().String()
+	if _, ok := visited[keyname]; ok {
+		return nil
+	}
+
+	visited[keyname] = true
+	name, err := app.tokenName(state, token)
+	if err != nil {
+		return err
+	}
+
+	state.taken[name] = true
+	for _, oneLine := range token.Lines() {
+		for _, oneElement := range oneLine.Elements() {
+			content := oneElement.Content()
+			if content.IsGrammar() {
+				err := app.names(state, content.Grammar(), visited)
+				if err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if !content.IsInstance() {
+				continue
+			}
+
+			instance := content.Instance()
+			if instance.IsToken() {
+				err := app.tokenNames(state, instance.Token(), visited)
+				if err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			everything := instance.Everything()
+			err := app.tokenNames(state, everything.Exception(), visited)
+			if err != nil {
+				return err
+			}
+
+			if everything.HasEscape() {
+				err := app.tokenNames(state, everything.Escape(), visited)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func (app *decompiler) tokenName(state *decompilation, token grammars.Token) (string, error) {
+	tokens := state.reference.Tokens()
+	_, err := tokens.Fetch(token.Hash())
+	if err != nil && !token.HasName() {
+		str := fmt.Sprintf("the token (hash: %s) does not have a name", token.Hash().String())
+		return "", errors.New(str)
+	}
+
+	return tokens.Name(token), nil
+}
+
+func (app *decompiler) channel(state *decompilation, channel grammars.Channel) (channelDeclaration, error) {
+	name, err := app.token(state, channel.Token())
+	if err != nil {
+		return channelDeclaration{}, err
+	}
+
+	out := channelDeclaration{
+		name: name,
+	}
+
+	if !channel.HasCondition() {
+		return out, nil
+	}
+
+	condition := channel.Condition()
+	if condition.HasPrevious() {
+		previous, err := app.token(state, condition.Previous())
+		if err != nil {
+			return channelDeclaration{}, err
+		}
+
+		out.previous = previous
+	}
+
+	if condition.HasNext() {
+		next, err := app.token(state, condition.Next())
+		if err != nil {
+			return channelDeclaration{}, err
+		}
+
+		out.next = next
+	}
+
+	return out, nil
+}
+
+func (app *decompiler) token(state *decompilation, token grammars.Token) (string, error) {
+	name, err := app.tokenName(state, token)
+	if err != nil {
+		return "", err
+	}
+
+	if state.compilation.isDeclared(name) {
+		return name, nil
+	}
+
+	state.tokens = append(state.tokens, name)
+	state.compilation.tokens[name] = tokenAssignment{}
+
+	lines := [][]lineElement{}
+	for _, oneLine := range token.Lines() {
+		elements := []lineElement{}
+		for _, oneElement := range oneLine.Elements() {
+			element, err := app.element(state, oneElement)
+			if err != nil {
+				return "", err
+			}
+
+			elements = append(elements, element)
+		}
+
+		lines = append(lines, elements)
+	}
+
+	suites := []suiteDeclaration{}
+	if token.HasSuites() {
+		for _, isValid := range []bool{true, false} {
+			suite, err := app.suite(state, name, token.Suites(), isValid)
+			if err != nil {
+				return "", err
+			}
+
+			if len(suite.names) > 0 {
+				suites = append(suites, suite)
+			}
+		}
+	}
+
+	state.compilation.tokens[name] = tokenAssignment{
+		lines:  lines,
+		suites: suites,
+	}
+
+	return name, nil
+}
+
+func (app *decompiler) suite(state *decompilation, name string, suites []grammars.Suite, isValid bool) (suiteDeclaration, error) {
+	prefix := fmt.Sprintf("%s%s", name, app.capitalize(invalidSuiteName))
+	if isValid {
+		prefix = fmt.Sprintf("%s%s", name, app.capitalize(validSuiteName))
+	}
+
+	names := []string{}
+	for _, oneSuite := range suites {
+		if oneSuite.IsValid() != isValid {
+			continue
+		}
+
+		content := oneSuite.Content()
+		if len(content) <= 0 {
+			str := fmt.Sprintf("the token (name: %s) contains an empty suite that cannot be decompiled", name)
+			return suiteDeclaration{}, errors.New(str)
+		}
+
+		composeName := fmt.Sprintf("%s%s", prefix, app.number(uint(len(names)+1)))
+		names = append(names, app.compose(state, composeName, content, false))
+	}
+
+	return suiteDeclaration{
+		isValid: isValid,
+		names:   names,
+	}, nil
+}
+
+func (app *decompiler) element(state *decompilation, element grammars.Element) (lineElement, error) {
+	cardinality := element.Cardinality()
+	out := lineElement{
+		min:  cardinality.Min(),
+		pMax: cardinality.Max(),
+	}
+
+	content := element.Content()
+	if content.IsValue() {
+		value := content.Value()
+		if len(value) <= 0 {
+			return lineElement{}, errors.New("the element contains an empty value that cannot be decompiled")
+		}
+
+		if len(value) == 1 {
+			out.name = app.value(state, value[0])
+			return out, nil
+		}
+
+		out.name = app.compose(state, app.bytesName(value), value, true)
+		return out, nil
+	}
+
+	if content.IsRecursive() {
+		out.name = content.Recursive()
+		return out, nil
+	}
+
+	if content.IsGrammar() {
+		grammar := content.Grammar()
+		if grammar.HasChannels() {
+			return lineElement{}, errors.New("the element contains an external grammar with channels that cannot be decompiled")
+		}
+
+		name, err := app.token(state, grammar.Root())
+		if err != nil {
+			return lineElement{}, err
+		}
+
+		out.name = name
+		out.isExternal = true
+		return out, nil
+	}
+
+	instance := content.Instance()
+	if instance.IsToken() {
+		name, err := app.token(state, instance.Token())
+		if err != nil {
+			return lineElement{}, err
+		}
+
+		out.name = name
+		return out, nil
+	}
+
+	name, err := app.everything(state, instance.Everything())
+	if err != nil {
+		return lineElement{}, err
+	}
+
+	out.name = name
+	return out, nil
+}
+
+func (app *decompiler) everything(state *decompilation, everything grammars.Everything) (string, error) {
+	exception, err := app.token(state, everything.Exception())
+	if err != nil {
+		return "", err
+	}
+
+	assignment := everythingAssignment{
+		exception: exception,
+	}
+
+	name := fmt.Sprintf("everything%s", app.capitalize(exception))
+	if everything.HasEscape() {
+		escape, err := app.token(state, everything.Escape())
+		if err != nil {
+			return "", err
+		}
+
+		assignment.escape = escape
+		name = fmt.Sprintf("%sEscape%s", name, app.capitalize(escape))
+	}
+
+	keyname := fmt.Sprintf("%s%s%s", exception, everythingEscapePrefixSign, assignment.escape)
+	if existing, ok := state.exceptions[keyname]; ok {
+		return existing, nil
+	}
+
+	name = app.unique(state, name)
+	state.exceptions[keyname] = name
+	state.everythings = append(state.everythings, name)
+	state.compilation.everythings[name] = assignment
+	return name, nil
+}
+
+func (app *decompiler) compose(state *decompilation, name string, value []byte, isShared bool) string {
+	keyname := string(value)
+	if name, ok := state.bytes[keyname]; ok && isShared {
+		return name
+	}
+
+	elements := []composeElement{}
+	for _, oneByte := range value {
+		valueName := app.value(state, oneByte)
+		last := len(elements) - 1
+		if last >= 0 && elements[last].name == valueName {
+			elements[last].amount++
+			continue
+		}
+
+		elements = append(elements, composeElement{
+			name:   valueName,
+			amount: 1,
+		})
+	}
+
+	name = app.unique(state, name)
+	if isShared {
+		state.bytes[keyname] = name
+	}
+
+	state.composes = append(state.composes, name)
+	state.compilation.composes[name] = composeAssignment{
+		elements: elements,
+	}
+
+	return name
+}
+
+func (app *decompiler) value(state *decompilation, value byte) string {
+	if name, ok := state.values[value]; ok {
+		return name
+	}
+
+	name := app.unique(state, app.byteName(value))
+	state.values[value] = name
+	state.compilation.values[name] = value
+	return name
+}
+
+func (app *decompiler) unique(state *decompilation, name string) string {
+	for state.taken[name] || state.compilation.isDeclared(name) {
+		name = fmt.Sprintf("%sValue", name)
+	}
+
+	state.taken[name] = true
+	return name
+}
+
+func (app *decompiler) bytesName(value []byte) string {
+	output := app.byteName(value[0])
+	for _, oneByte := range value[1:] {
+		output = fmt.Sprintf("%s%s", output, app.capitalize(app.byteName(oneByte)))
+	}
+
+	return output
+}
+
+func (app *decompiler) byteName(value byte) string {
+	if name, ok := byteNames[value]; ok {
+		return name
+	}
+
+	if value >= 'a' && value <= 'z' {
+		return fmt.Sprintf("lower%s", strings.ToUpper(string(value)))
+	}
+
+	if value >= 'A' && value <= 'Z' {
+		return fmt.Sprintf("upper%s", string(value))
+	}
+
+	if value >= '0' && value <= '9' {
+		return fmt.Sprintf("digit%s", app.capitalize(digitNames[value-'0']))
+	}
+
+	return fmt.Sprintf("byte%s", app.number(uint(value)))
+}
+
+func (app *decompiler) number(value uint) string {
+	output := ""
+	for _, oneDigit := range fmt.Sprintf("%d", value) {
+		output = fmt.Sprintf("%s%s", output, app.capitalize(digitNames[oneDigit-'0']))
+	}
+
+	return output
+}
+
+func (app *decompiler) capitalize(name string) string {
+	if name == "" {
+		return name
+	}
+
+	return fmt.Sprintf("%s%s", strings.ToUpper(name[:1]), name[1:])
+}
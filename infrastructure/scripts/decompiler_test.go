@@ -0,0 +1,122 @@
+package scripts
+
+import (
+	"bytes"
+	"testing"
+
+	ast_applications "github.com/steve-care-software/grammars/applications"
+)
+
+func TestDecompiler_Success(t *testing.T) {
+	script := `
+		@expression;
+		-space;
+
+		expression: term plusTerm*
+			---
+			valid	: onePlusTwo
+					& two
+					;
+
+			invalid: plus;
+		;
+
+		plusTerm: plus term;
+		term: number | parenthesis;
+		parenthesis: open expression close;
+		number: digit[1,3];
+		digit: zero | one | two;
+
+		zero: 48;
+		one: 49;
+		two: 50;
+		plus: 43;
+		open: 40;
+		close: 41;
+		space: 32;
+		onePlusTwo: one plus two;
+	`
+
+	reference, err := NewCompiler().Compile([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	decompiled, err := NewDecompiler().Decompile(reference)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	retReference, err := NewCompiler().Compile(decompiled)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !reference.Root().Hash().Compare(retReference.Root().Hash()) {
+		t.Errorf("the decompiled script was expected to compile to the same grammar, script: \n%s", decompiled)
+		return
+	}
+}
+
+func TestDecompiler_withGrammar_Success(t *testing.T) {
+	grammar := NewGrammar().Grammar()
+	decompiled, err := NewDecompiler().Decompile(grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.HasPrefix(decompiled, []byte("@grammar;")) {
+		t.Errorf("the decompiled script was expected to declare the grammar root, script: \n%s", decompiled)
+		return
+	}
+
+	retDecompiled, err := NewDecompiler().Decompile(grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !bytes.Equal(decompiled, retDecompiled) {
+		t.Errorf("the decompiled script was expected to be deterministic")
+		return
+	}
+}
+
+func TestDecompiler_withGrammar_compilesToTheSameGrammar(t *testing.T) {
+	grammar := NewGrammar().Grammar()
+	decompiled, err := NewDecompiler().Decompile(grammar)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	reference, err := NewCompiler().Compile(decompiled)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if !grammar.Root().Hash().Compare(reference.Root().Hash()) {
+		t.Errorf("the decompiled script was expected to compile to the same grammar, script: \n%s", decompiled)
+		return
+	}
+
+	// the external grammars keep the channels outside of the names and numbers:
+	grammarApp := ast_applications.NewApplication()
+	invalidScripts := []string{
+		"@my Value;",
+		"@a; a: 4 5;",
+	}
+
+	for _, oneScript := range invalidScripts {
+		tree, err := grammarApp.Execute(reference.Root(), []byte(oneScript))
+		if err == nil && !tree.HasRemaining() {
+			t.Errorf("the script (%s) was expected to be invalid", oneScript)
+			return
+		}
+	}
+}
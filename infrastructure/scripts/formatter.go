@@ -0,0 +1,148 @@
+package scripts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/steve-care-software/grammars/domain/trees"
+)
+
+type formatter struct {
+	compiler *compiler
+}
+
+type commenting struct {
+	compilation *compilation
+	instruction string
+	current     string
+	previous    string
+	pendings    []string
+	isNewLine   bool
+	isInLine    bool
+	lines       int
+	suites      int
+}
+
+func createFormatter(
+	compiler *compiler,
+) Formatter {
+	out := formatter{
+		compiler: compiler,
+	}
+
+	return &out
+}
+
+// Format formats a script using the canonical layout
+func (app *formatter) Format(script []byte) ([]byte, error) {
+	tree, compilation, err := app.compiler.compilation(script)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &commenting{
+		compilation: compilation,
+		pendings:    []string{},
+	}
+
+	app.treeComments(state, tree.Prefix(), tree.HasPrefix())
+	for _, oneTree := range app.compiler.children(tree) {
+		state.instruction = app.keyname(oneTree)
+		state.current = state.instruction
+		state.lines = 0
+		state.suites = 0
+		app.tokenComments(state, oneTree)
+	}
+
+	app.treeComments(state, tree.Suffix(), tree.HasSuffix())
+	compilation.footers = state.pendings
+	return compilation.script(), nil
+}
+
+func (app *formatter) keyname(tree trees.Tree) string {
+	switch tree.Grammar().Name() {
+	case rootTokenName:
+		return rootPrefix
+	case channelTokenName:
+		name := app.compiler.text(app.compiler.fetch(tree, variableNameTokenName)[0])
+		return fmt.Sprintf("%s%s", channelPrefix, name)
+	}
+
+	instruction := app.compiler.children(tree)[0]
+	return app.compiler.text(app.compiler.fetch(instruction, variableNameTokenName)[0])
+}
+
+func (app *formatter) tokenComments(state *commenting, tree trees.Tree) {
+	// the comments are attached to the line or suite they sit next to:
+	current := state.current
+	isInLine := state.isInLine
+	switch tree.Grammar().Name() {
+	case delimiterThenLineTokenName, lineTokenName:
+		if !state.isInLine {
+			state.current = lineKeyname(state.instruction, state.lines)
+			state.isInLine = true
+			state.lines++
+		}
+	case suiteTokenName:
+		state.current = suiteKeyname(state.instruction, state.suites)
+		if state.suites <= 0 {
+			state.current = suitePrefixKeyname(state.instruction)
+		}
+	case suiteValidTokenName, suiteInvalidTokenName:
+		state.current = suiteKeyname(state.instruction, state.suites)
+		state.suites++
+	}
+
+	app.treeComments(state, tree.Prefix(), tree.HasPrefix())
+	if tree.Token().HasSuccessful() {
+		for _, oneElement := range tree.Token().Successful().Elements() {
+			for _, oneContent := range oneElement.Contents() {
+				if oneContent.IsTree() {
+					app.tokenComments(state, oneContent.Tree())
+					continue
+				}
+
+				value := oneContent.Value()
+				app.treeComments(state, value.Prefix(), value.HasPrefix())
+				if len(state.pendings) > 0 {
+					leadings := state.compilation.leadings[state.current]
+					state.compilation.leadings[state.current] = append(leadings, state.pendings...)
+					state.pendings = []string{}
+				}
+
+				state.previous = state.current
+				state.isNewLine = false
+			}
+		}
+	}
+
+	app.treeComments(state, tree.Suffix(), tree.HasSuffix())
+	state.current = current
+	state.isInLine = isInLine
+}
+
+func (app *formatter) treeComments(state *commenting, channels trees.Trees, hasChannels bool) {
+	if !hasChannels {
+		return
+	}
+
+	for _, oneChannel := range channels.List() {
+		switch oneChannel.Grammar().Name() {
+		case newLineTokenName:
+			state.isNewLine = true
+		case singleLineCommentTokenName:
+			comment := strings.TrimSpace(string(oneChannel.Bytes(true)))
+			app.comment(state, comment)
+		}
+	}
+}
+
+func (app *formatter) comment(state *commenting, comment string) {
+	if !state.isNewLine && state.previous != "" {
+		trailings := state.compilation.trailings[state.previous]
+		state.compilation.trailings[state.previous] = append(trailings, comment)
+		return
+	}
+
+	state.pendings = append(state.pendings, comment)
+}
@@ -0,0 +1,193 @@
+package scripts
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatter_Success(t *testing.T) {
+	script := `
+		// this is the root entry point:
+		@expression; // the root
+
+		// the channels:
+		-space;
+		-newLine [space:space];
+
+		expression: term plusTerm* // the expression
+			---
+			valid	: onePlusTwo
+					& two
+					;
+
+			invalid : plus;
+		;
+
+		// the terms:
+		plusTerm: plus term;
+		term: number | number[0,1]
+			| number[2,];
+
+		number: digit+; digit: one | two;
+		anything: #one!two;
+		onePlusTwo: one plus two|2;
+		one: 49; two: 50; plus: 43;
+		space: 32;
+		newLine: 10;
+		// end of the script
+	`
+
+	expected := `// this is the root entry point:
+@expression; // the root
+
+// the channels:
+-space;
+-newLine [space:space];
+
+expression: term plusTerm* // the expression
+	---
+	valid: onePlusTwo
+	     & two;
+	invalid: plus;
+;
+
+// the terms:
+plusTerm: plus term;
+
+term: number
+	| number?
+	| number[2,]
+;
+
+number: digit+;
+
+digit: one
+	| two
+;
+
+anything: #one!two;
+onePlusTwo: one plus two|2;
+one: 49;
+two: 50;
+plus: 43;
+space: 32;
+newLine: 10;
+
+// end of the script
+`
+
+	formatter := NewFormatter()
+	formatted, err := formatter.Format([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(formatted) != expected {
+		t.Errorf("the formatted script was expected to be: \n%s\n, returned: \n%s", expected, formatted)
+		return
+	}
+
+	retFormatted, err := formatter.Format(formatted)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(retFormatted) != expected {
+		t.Errorf("the formatted script was expected to remain the same, returned: \n%s", retFormatted)
+		return
+	}
+}
+
+func TestFormatter_withCommentsInsideBlocks_Success(t *testing.T) {
+	script := `
+		@term;
+		term: number // the number
+			// the first optional number:
+			| number? // optional
+			| number[2,] // at least twice
+			---
+			// the valid suites:
+			valid	: one // the one
+					& two
+					; // both
+			// the invalid suites:
+			invalid	: plus;
+		; // the term
+
+		number: one | two;
+		one: 49; two: 50; plus: 43;
+	`
+
+	expected := `@term;
+
+term: number // the number
+	// the first optional number:
+	| number? // optional
+	| number[2,] // at least twice
+	---
+	// the valid suites:
+	valid: one
+	     & two; // the one // both
+	// the invalid suites:
+	invalid: plus;
+; // the term
+
+number: one
+	| two
+;
+
+one: 49;
+two: 50;
+plus: 43;
+`
+
+	formatter := NewFormatter()
+	formatted, err := formatter.Format([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(formatted) != expected {
+		t.Errorf("the formatted script was expected to be: \n%s\n, returned: \n%s", expected, formatted)
+		return
+	}
+
+	retFormatted, err := formatter.Format(formatted)
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	if string(retFormatted) != string(formatted) {
+		t.Errorf("the formatted script was expected to remain the same, returned: \n%s", retFormatted)
+		return
+	}
+
+	// every comment keeps its position relative to the other comments:
+	comments := scriptComments(script)
+	retComments := scriptComments(string(formatted))
+	if strings.Join(comments, "\n") != strings.Join(retComments, "\n") {
+		t.Errorf("the comments were expected to be: \n%v\n, returned: \n%v", comments, retComments)
+		return
+	}
+}
+
+func scriptComments(script string) []string {
+	output := []string{}
+	for _, oneLine := range strings.Split(script, "\n") {
+		for idx := strings.Index(oneLine, "//"); idx >= 0; idx = strings.Index(oneLine, "//") {
+			oneLine = oneLine[idx+2:]
+			end := strings.Index(oneLine, "//")
+			if end < 0 {
+				end = len(oneLine)
+			}
+
+			output = append(output, strings.TrimSpace(oneLine[:end]))
+		}
+	}
+
+	return output
+}
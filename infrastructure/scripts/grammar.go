@@ -1,5 +1,7 @@
 package scripts
 
+//go:generate go run ../../cmd/grammardiagrams -scripts -o ../../docs/diagrams
+
 import (
 	grammars "github.com/steve-care-software/grammars/domain"
 	"github.com/steve-care-software/grammars/domain/references"
@@ -8,11 +10,13 @@ import (
 )
 
 type grammar struct {
-	component        components.Component
-	token            tokens.Token
-	builder          grammars.Builder
-	refBuilder       references.Builder
-	refTokensBuilder references.TokensBuilder
+	component          components.Component
+	token              tokens.Token
+	builder            grammars.Builder
+	refBuilder         references.Builder
+	refTokensBuilder   references.TokensBuilder
+	refGrammarsBuilder references.GrammarsBuilder
+	refGrammarBuilder  references.GrammarBuilder
 }
 
 func createGrammar(
@@ -21,13 +25,17 @@ func createGrammar(
 	builder grammars.Builder,
 	refBuilder references.Builder,
 	refTokensBuilder references.TokensBuilder,
+	refGrammarsBuilder references.GrammarsBuilder,
+	refGrammarBuilder references.GrammarBuilder,
 ) Grammar {
 	out := grammar{
-		component:        component,
-		token:            token,
-		builder:          builder,
-		refBuilder:       refBuilder,
-		refTokensBuilder: refTokensBuilder,
+		component:          component,
+		token:              token,
+		builder:            builder,
+		refBuilder:         refBuilder,
+		refTokensBuilder:   refTokensBuilder,
+		refGrammarsBuilder: refGrammarsBuilder,
+		refGrammarBuilder:  refGrammarBuilder,
 	}
 
 	return &out
@@ -46,16 +54,66 @@ func (app *grammar) Grammar() references.Reference {
 		panic(err)
 	}
 
+	variableName, subVariableName := app.variableNameGrammar()
+	number, subNumber := app.numberGrammar()
 	nameTokens := []references.Token{}
 	nameTokens = append(nameTokens, root)
 	nameTokens = append(nameTokens, subRoot...)
 	nameTokens = append(nameTokens, sunChannels...)
+	nameTokens = append(nameTokens, subVariableName...)
+	nameTokens = append(nameTokens, subNumber...)
 	scriptTokens, err := app.refTokensBuilder.Create().WithList(nameTokens).Now()
 	if err != nil {
 		panic(err)
 	}
 
-	ins, err := app.refBuilder.Create().WithRoot(grammar).WithTokens(scriptTokens).Now()
+	scriptGrammars, err := app.refGrammarsBuilder.Create().WithList([]references.Grammar{
+		variableName,
+		number,
+	}).Now()
+
+	if err != nil {
+		panic(err)
+	}
+
+	ins, err := app.refBuilder.Create().
+		WithRoot(grammar).
+		WithTokens(scriptTokens).
+		WithGrammars(scriptGrammars).
+		Now()
+
+	if err != nil {
+		panic(err)
+	}
+
+	return ins
+}
+
+func (app *grammar) variableNameGrammar() (references.Grammar, []references.Token) {
+	variableName, subVariableName := app.token.VariableName()
+	return app.externalGrammar(variableName), append(subVariableName, variableName)
+}
+
+func (app *grammar) numberGrammar() (references.Grammar, []references.Token) {
+	number, subNumber := app.token.Number()
+	return app.externalGrammar(number), append(subNumber, number)
+}
+
+// externalGrammar wraps a lexical token in a grammar without channels, so that no channel can be skipped inside of it
+func (app *grammar) externalGrammar(token references.Token) references.Grammar {
+	grammar, err := app.builder.Create().
+		WithRoot(token.Reference()).
+		Now()
+
+	if err != nil {
+		panic(err)
+	}
+
+	ins, err := app.refGrammarBuilder.Create().
+		WithName(token.Name()).
+		WithReference(grammar).
+		Now()
+
 	if err != nil {
 		panic(err)
 	}
@@ -198,10 +256,9 @@ func (app *grammar) instructionToken() (references.Token, []references.Token) {
 }
 
 func (app *grammar) rootToken() (references.Token, []references.Token) {
-	variableName, subVariableName := app.token.VariableName()
+	variableName, subVariableName := app.variableNameGrammar()
 
 	output := []references.Token{}
-	output = append(output, variableName)
 	output = append(output, subVariableName...)
 
 	return app.component.Token().FromLines(
@@ -209,7 +266,7 @@ func (app *grammar) rootToken() (references.Token, []references.Token) {
 		[]grammars.Line{
 			app.component.Line().FromElements([]grammars.Element{
 				app.component.Element().FromValue([]byte(rootPrefix)),
-				app.component.Element().FromToken(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
 				app.component.Element().FromValue([]byte(rootSuffix)),
 			}),
 		},
@@ -220,11 +277,10 @@ func (app *grammar) rootToken() (references.Token, []references.Token) {
 }
 
 func (app *grammar) channelToken() (references.Token, []references.Token) {
-	variableName, subVariableName := app.token.VariableName()
+	variableName, subVariableName := app.variableNameGrammar()
 	chanPrevNext, subChanPrevNext := app.channelPreviousNextToken()
 
 	output := []references.Token{}
-	output = append(output, variableName)
 	output = append(output, subVariableName...)
 	output = append(output, chanPrevNext)
 	output = append(output, subChanPrevNext...)
@@ -235,7 +291,7 @@ func (app *grammar) channelToken() (references.Token, []references.Token) {
 		[]grammars.Line{
 			app.component.Line().FromElements([]grammars.Element{
 				app.component.Element().FromValue([]byte(channelPrefix)),
-				app.component.Element().FromToken(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
 				app.component.Element().FromToken(chanPrevNext.Reference(), app.component.Cardinality().Cardinality(0, &pMax)),
 				app.component.Element().FromValue([]byte(channelSuffix)),
 			}),
@@ -274,26 +330,25 @@ func (app *grammar) channelPreviousNextToken() (references.Token, []references.T
 }
 
 func (app *grammar) channelPreviousNextInsideToken() (references.Token, []references.Token) {
-	variableName, subVariableName := app.token.VariableName()
+	variableName, subVariableName := app.variableNameGrammar()
 
 	output := []references.Token{}
-	output = append(output, variableName)
 	output = append(output, subVariableName...)
 
 	return app.component.Token().FromLines(
 		"channelPreviousNextInside",
 		[]grammars.Line{
 			app.component.Line().FromElements([]grammars.Element{
-				app.component.Element().FromToken(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
 				app.component.Element().FromValue([]byte(channelPrevNextDelimiter)),
-				app.component.Element().FromToken(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
 			}),
 			app.component.Line().FromElements([]grammars.Element{
 				app.component.Element().FromValue([]byte(channelPrevNextDelimiter)),
-				app.component.Element().FromToken(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
 			}),
 			app.component.Line().FromElements([]grammars.Element{
-				app.component.Element().FromToken(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
 			}),
 		},
 		app.component.Suite().Suites(map[string]bool{
@@ -305,27 +360,31 @@ func (app *grammar) channelPreviousNextInsideToken() (references.Token, []refere
 }
 
 func (app *grammar) tokenAssignmentToken() (references.Token, []references.Token) {
-	variableName, subVariableName := app.token.VariableName()
+	variableName, subVariableName := app.variableNameGrammar()
 	blockToken, subBlockToken := app.blockToken()
 	suite, subSuite := app.suiteToken()
+	suiteSection, subSuiteSection := app.suiteSectionToken()
 
 	output := []references.Token{}
-	output = append(output, variableName)
 	output = append(output, subVariableName...)
 	output = append(output, blockToken)
 	output = append(output, subBlockToken...)
 	output = append(output, suite)
 	output = append(output, subSuite...)
+	output = append(output, suiteSection)
+	output = append(output, subSuiteSection...)
 
+	pMax := uint(1)
 	return app.component.Token().FromLines(
 		"tokenAssignment",
 		[]grammars.Line{
 			app.component.Line().FromElements([]grammars.Element{
-				app.component.Element().FromToken(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
 				app.component.Element().FromValue([]byte(assignmentSign)),
 				app.component.Element().FromToken(blockToken.Reference(), app.component.Cardinality().Once()),
-				app.component.Element().FromToken(suite.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromToken(suite.Reference(), app.component.Cardinality().Cardinality(0, &pMax)),
 				app.component.Element().FromValue([]byte(blockSuffix)),
+				app.component.Element().FromToken(suiteSection.Reference(), app.component.Cardinality().Cardinality(0, nil)),
 			}),
 		},
 		app.component.Suite().Suites(map[string]bool{
@@ -355,27 +414,29 @@ func (app *grammar) tokenAssignmentToken() (references.Token, []references.Token
 }
 
 func (app *grammar) valueAssignmentToken() (references.Token, []references.Token) {
-	variableName, subVariableName := app.token.VariableName()
-	anyByte := app.token.AnyByte()
+	variableName, subVariableName := app.variableNameGrammar()
+	number, subNumber := app.numberGrammar()
 
 	output := []references.Token{}
-	output = append(output, variableName)
 	output = append(output, subVariableName...)
-	output = append(output, anyByte)
+	output = append(output, subNumber...)
 
 	return app.component.Token().FromLines(
 		"valueAssignment",
 		[]grammars.Line{
 			app.component.Line().FromElements([]grammars.Element{
-				app.component.Element().FromToken(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
 				app.component.Element().FromValue([]byte(assignmentSign)),
-				app.component.Element().FromToken(anyByte.Reference(), app.component.Cardinality().Cardinality(1, nil)),
+				app.component.Element().FromGrammar(number.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromValue([]byte(blockSuffix)),
 			}),
 		},
 		app.component.Suite().Suites(map[string]bool{
 			`
 				myValue: 45;
 			`: true,
+			`myValue: 45`:       false,
+			`myValue: myToken;`: false,
 		}),
 	), output
 }
@@ -445,6 +506,37 @@ func (app *grammar) suiteToken() (references.Token, []references.Token) {
 	), output
 }
 
+func (app *grammar) suiteSectionToken() (references.Token, []references.Token) {
+	suite, subSuite := app.suiteToken()
+
+	output := []references.Token{}
+	output = append(output, suite)
+	output = append(output, subSuite...)
+
+	return app.component.Token().FromLines(
+		"suiteSection",
+		[]grammars.Line{
+			app.component.Line().FromElements([]grammars.Element{
+				app.component.Element().FromToken(suite.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromValue([]byte(blockSuffix)),
+			}),
+		},
+		app.component.Suite().Suites(map[string]bool{
+			`
+				---
+				valid	: firstValidCompose
+						& secondValidCompose
+						;
+			;
+			`: true,
+			`
+				---
+				valid: firstValidCompose;
+			`: false,
+		}),
+	), output
+}
+
 func (app *grammar) suiteInvalidToken() (references.Token, []references.Token) {
 	invalidCons := app.component.Token().AllCharacters("invalidConst", invalidSuiteName)
 	suiteBlock, subSuiteBlock := app.suiteBlockToken()
@@ -503,11 +595,10 @@ func (app *grammar) suiteValidToken() (references.Token, []references.Token) {
 }
 
 func (app *grammar) suiteBlockToken() (references.Token, []references.Token) {
-	variableName, subVariableName := app.token.VariableName()
+	variableName, subVariableName := app.variableNameGrammar()
 	delimiterThenSuite, subDelimiterThenSuite := app.delimiterThenSuiteElementToken()
 
 	output := []references.Token{}
-	output = append(output, variableName)
 	output = append(output, subVariableName...)
 	output = append(output, delimiterThenSuite)
 	output = append(output, subDelimiterThenSuite...)
@@ -517,7 +608,7 @@ func (app *grammar) suiteBlockToken() (references.Token, []references.Token) {
 		[]grammars.Line{
 			app.component.Line().FromElements([]grammars.Element{
 				app.component.Element().FromValue([]byte(assignmentSign)),
-				app.component.Element().FromToken(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
 				app.component.Element().FromToken(delimiterThenSuite.Reference(), app.component.Cardinality().Cardinality(0, nil)),
 				app.component.Element().FromValue([]byte(suiteSuffix)),
 			}),
@@ -530,19 +621,19 @@ func (app *grammar) suiteBlockToken() (references.Token, []references.Token) {
 }
 
 func (app *grammar) delimiterThenSuiteElementToken() (references.Token, []references.Token) {
-	variableName, subVariableName := app.token.VariableName()
+	variableName, subVariableName := app.variableNameGrammar()
 	return app.component.Token().FromLines(
 		"delimiterThenSuiteElement",
 		[]grammars.Line{
 			app.component.Line().FromElements([]grammars.Element{
 				app.component.Element().FromValue([]byte(suiteDelimiter)),
-				app.component.Element().FromToken(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
 			}),
 		},
 		app.component.Suite().Suites(map[string]bool{
 			`& myComposeToken`: true,
 		}),
-	), append(subVariableName, variableName)
+	), subVariableName
 }
 
 func (app *grammar) blockToken() (references.Token, []references.Token) {
@@ -607,21 +698,28 @@ func (app *grammar) lineToken() (references.Token, []references.Token) {
 }
 
 func (app *grammar) elementToken() (references.Token, []references.Token) {
-	variableName, subVariableName := app.token.VariableName()
+	variableName, subVariableName := app.variableNameGrammar()
+	external, subExternal := app.externalToken()
 	cardinality, subCardinality := app.cardinalityToken()
 
 	output := []references.Token{}
-	output = append(output, variableName)
 	output = append(output, subVariableName...)
+	output = append(output, external)
+	output = append(output, subExternal...)
 	output = append(output, cardinality)
 	output = append(output, subCardinality...)
 
+	pMax := uint(1)
 	return app.component.Token().FromLines(
 		"element",
 		[]grammars.Line{
 			app.component.Line().FromElements([]grammars.Element{
-				app.component.Element().FromToken(variableName.Reference(), app.component.Cardinality().Once()),
-				app.component.Element().FromToken(cardinality.Reference(), app.component.Cardinality().Cardinality(0, nil)),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromToken(cardinality.Reference(), app.component.Cardinality().Cardinality(0, &pMax)),
+			}),
+			app.component.Line().FromElements([]grammars.Element{
+				app.component.Element().FromToken(external.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromToken(cardinality.Reference(), app.component.Cardinality().Cardinality(0, &pMax)),
 			}),
 		},
 		app.component.Suite().Suites(map[string]bool{
@@ -633,12 +731,35 @@ func (app *grammar) elementToken() (references.Token, []references.Token) {
 			`myToken[0,]`:    true,
 			`myToken[1,]`:    true,
 			`myToken[0,234]`: true,
+			`{myToken}`:      true,
+			`{myToken}+`:     true,
 		}),
 	), output
 }
 
+func (app *grammar) externalToken() (references.Token, []references.Token) {
+	variableName, subVariableName := app.variableNameGrammar()
+	return app.component.Token().FromLines(
+		"external",
+		[]grammars.Line{
+			app.component.Line().FromElements([]grammars.Element{
+				app.component.Element().FromValue([]byte(externalTokenPrefix)),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromValue([]byte(externalTokenSuffix)),
+			}),
+		},
+		app.component.Suite().Suites(map[string]bool{
+			`{myToken}`:   true,
+			`{ myToken }`: true,
+			`{myToken`:    false,
+			`myToken}`:    false,
+		}),
+	), subVariableName
+}
+
 func (app *grammar) cardinalityToken() (references.Token, []references.Token) {
-	anyNumber := app.token.AnyNumber()
+	number, subNumber := app.numberGrammar()
+	pMax := uint(1)
 	return app.component.Token().FromLines(
 			"cardinality",
 			[]grammars.Line{
@@ -653,14 +774,14 @@ func (app *grammar) cardinalityToken() (references.Token, []references.Token) {
 				}),
 				app.component.Line().FromElements([]grammars.Element{
 					app.component.Element().FromValue([]byte(cardinalityPrefix)),
-					app.component.Element().FromToken(anyNumber.Reference(), app.component.Cardinality().Cardinality(1, nil)),
+					app.component.Element().FromGrammar(number.Reference(), app.component.Cardinality().Once()),
 					app.component.Element().FromValue([]byte(cardinalitySuffix)),
 				}),
 				app.component.Line().FromElements([]grammars.Element{
 					app.component.Element().FromValue([]byte(cardinalityPrefix)),
-					app.component.Element().FromToken(anyNumber.Reference(), app.component.Cardinality().Cardinality(1, nil)),
+					app.component.Element().FromGrammar(number.Reference(), app.component.Cardinality().Once()),
 					app.component.Element().FromValue([]byte(cardinalitySeparator)),
-					app.component.Element().FromToken(anyNumber.Reference(), app.component.Cardinality().Cardinality(0, nil)),
+					app.component.Element().FromGrammar(number.Reference(), app.component.Cardinality().Cardinality(0, &pMax)),
 					app.component.Element().FromValue([]byte(cardinalitySuffix)),
 				}),
 			},
@@ -673,34 +794,38 @@ func (app *grammar) cardinalityToken() (references.Token, []references.Token) {
 				`[0,]`:    true,
 				`[1,]`:    true,
 				`[0,234]`: true,
+				`[]`:      false,
+				`[,2]`:    false,
 			}),
-		), []references.Token{
-			anyNumber,
-		}
+		), subNumber
 }
 
 func (app *grammar) composeAssignmentToken() (references.Token, []references.Token) {
-	variableName, subVariableName := app.token.VariableName()
+	variableName, subVariableName := app.variableNameGrammar()
 	composeToken, subComposeToken := app.composeToken()
 	suite, subSuite := app.suiteToken()
+	suiteSection, subSuiteSection := app.suiteSectionToken()
 
 	output := []references.Token{}
-	output = append(output, variableName)
 	output = append(output, subVariableName...)
 	output = append(output, composeToken)
 	output = append(output, subComposeToken...)
 	output = append(output, suite)
 	output = append(output, subSuite...)
+	output = append(output, suiteSection)
+	output = append(output, subSuiteSection...)
 
+	pMax := uint(1)
 	return app.component.Token().FromLines(
 		"composeAssignment",
 		[]grammars.Line{
 			app.component.Line().FromElements([]grammars.Element{
-				app.component.Element().FromToken(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
 				app.component.Element().FromValue([]byte(assignmentSign)),
 				app.component.Element().FromToken(composeToken.Reference(), app.component.Cardinality().Once()),
-				app.component.Element().FromToken(suite.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromToken(suite.Reference(), app.component.Cardinality().Cardinality(0, &pMax)),
 				app.component.Element().FromValue([]byte(blockSuffix)),
+				app.component.Element().FromToken(suiteSection.Reference(), app.component.Cardinality().Cardinality(0, nil)),
 			}),
 		},
 		app.component.Suite().Suites(map[string]bool{
@@ -724,21 +849,43 @@ func (app *grammar) composeAssignmentToken() (references.Token, []references.Tok
 }
 
 func (app *grammar) composeToken() (references.Token, []references.Token) {
-	variableName, subVariableName := app.token.VariableName()
+	composeElement, subComposeElement := app.composeElementToken()
+
+	output := []references.Token{}
+	output = append(output, composeElement)
+	output = append(output, subComposeElement...)
+
+	return app.component.Token().FromLines(
+		"compose",
+		[]grammars.Line{
+			app.component.Line().FromElements([]grammars.Element{
+				app.component.Element().FromToken(composeElement.Reference(), app.component.Cardinality().Cardinality(1, nil)),
+			}),
+		},
+		app.component.Suite().Suites(map[string]bool{
+			`myCompose`:                        true,
+			`myCompose|4`:                      true,
+			`myCompose|234 mySecond myThird|2`: true,
+		}),
+	), output
+}
+
+func (app *grammar) composeElementToken() (references.Token, []references.Token) {
+	variableName, subVariableName := app.variableNameGrammar()
 	separator, subSeparator := app.separatorAmountOfComposeToken()
 
 	output := []references.Token{}
-	output = append(output, variableName)
 	output = append(output, subVariableName...)
 	output = append(output, separator)
 	output = append(output, subSeparator...)
 
+	pMax := uint(1)
 	return app.component.Token().FromLines(
-		"compose",
+		"composeElement",
 		[]grammars.Line{
 			app.component.Line().FromElements([]grammars.Element{
-				app.component.Element().FromToken(variableName.Reference(), app.component.Cardinality().Once()),
-				app.component.Element().FromToken(separator.Reference(), app.component.Cardinality().Cardinality(0, nil)),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromToken(separator.Reference(), app.component.Cardinality().Cardinality(0, &pMax)),
 			}),
 		},
 		app.component.Suite().Suites(map[string]bool{
@@ -750,46 +897,48 @@ func (app *grammar) composeToken() (references.Token, []references.Token) {
 }
 
 func (app *grammar) separatorAmountOfComposeToken() (references.Token, []references.Token) {
-	anyNumber := app.token.AnyNumber()
+	number, subNumber := app.numberGrammar()
 	return app.component.Token().FromLines(
 			"composeWithAmount",
 			[]grammars.Line{
 				app.component.Line().FromElements([]grammars.Element{
 					app.component.Element().FromValue([]byte(amountSeparator)),
-					app.component.Element().FromToken(anyNumber.Reference(), app.component.Cardinality().Cardinality(1, nil)),
+					app.component.Element().FromGrammar(number.Reference(), app.component.Cardinality().Once()),
 				}),
 			},
 			app.component.Suite().Suites(map[string]bool{
 				`|4`:   true,
 				`|234`: true,
 			}),
-		), []references.Token{
-			anyNumber,
-		}
+		), subNumber
 }
 
 func (app *grammar) everythingAssignmentToken() (references.Token, []references.Token) {
-	variableName, subVariableNames := app.token.VariableName()
+	variableName, subVariableNames := app.variableNameGrammar()
 	everything, subEverything := app.everythingToken()
 	suite, subSuite := app.suiteToken()
+	suiteSection, subSuiteSection := app.suiteSectionToken()
 
 	output := []references.Token{}
-	output = append(output, variableName)
 	output = append(output, subVariableNames...)
 	output = append(output, everything)
 	output = append(output, subEverything...)
 	output = append(output, suite)
 	output = append(output, subSuite...)
+	output = append(output, suiteSection)
+	output = append(output, subSuiteSection...)
 
+	pMax := uint(1)
 	return app.component.Token().FromLines(
 		"everythingAssignment",
 		[]grammars.Line{
 			app.component.Line().FromElements([]grammars.Element{
-				app.component.Element().FromToken(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
 				app.component.Element().FromValue([]byte(assignmentSign)),
 				app.component.Element().FromToken(everything.Reference(), app.component.Cardinality().Once()),
-				app.component.Element().FromToken(suite.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromToken(suite.Reference(), app.component.Cardinality().Cardinality(0, &pMax)),
 				app.component.Element().FromValue([]byte(blockSuffix)),
+				app.component.Element().FromToken(suiteSection.Reference(), app.component.Cardinality().Cardinality(0, nil)),
 			}),
 		},
 		app.component.Suite().Suites(map[string]bool{
@@ -834,35 +983,35 @@ func (app *grammar) everythingToken() (references.Token, []references.Token) {
 }
 
 func (app *grammar) everythingWithEscapeToken() (references.Token, []references.Token) {
-	variableName, namesVariasbleName := app.token.VariableName()
+	variableName, namesVariasbleName := app.variableNameGrammar()
 	return app.component.Token().FromLines(
 		"everythingWithEscape",
 		[]grammars.Line{
 			app.component.Line().FromElements([]grammars.Element{
 				app.component.Element().FromValue([]byte(everythingPrefixSign)),
-				app.component.Element().FromToken(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
 				app.component.Element().FromValue([]byte(everythingEscapePrefixSign)),
-				app.component.Element().FromToken(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
 			}),
 		},
 		app.component.Suite().Suites(map[string]bool{
 			"#myToken!myEscape": true,
 		}),
-	), append(namesVariasbleName, variableName)
+	), namesVariasbleName
 }
 
 func (app *grammar) everythingWithoutEscapeToken() (references.Token, []references.Token) {
-	variableName, namesVariasbleName := app.token.VariableName()
+	variableName, namesVariasbleName := app.variableNameGrammar()
 	return app.component.Token().FromLines(
 		"everythingWithoutEscape",
 		[]grammars.Line{
 			app.component.Line().FromElements([]grammars.Element{
 				app.component.Element().FromValue([]byte(everythingPrefixSign)),
-				app.component.Element().FromToken(variableName.Reference(), app.component.Cardinality().Once()),
+				app.component.Element().FromGrammar(variableName.Reference(), app.component.Cardinality().Once()),
 			}),
 		},
 		app.component.Suite().Suites(map[string]bool{
 			"#myToken": true,
 		}),
-	), append(namesVariasbleName, variableName)
+	), namesVariasbleName
 }
@@ -4,38 +4,17 @@ import (
 	"testing"
 
 	ast_applications "github.com/steve-care-software/grammars/applications"
+	"github.com/steve-care-software/grammars/infrastructure/grammartest"
 )
 
 func TestGrammar_coverage_Success(t *testing.T) {
-	grammarApp := ast_applications.NewApplication()
-	ins := NewGrammar().Grammar()
-	coverages, err := grammarApp.Coverages(ins)
+	options, err := grammartest.NewOptionsBuilder().Create().IsStrict().Now()
 	if err != nil {
 		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
 		return
 	}
 
-	if coverages.ContainsError() {
-		list := coverages.List()
-		for _, oneCoverage := range list {
-			executions := oneCoverage.Executions()
-			token := oneCoverage.Token()
-			executionsList := executions.List()
-			for idx, oneExecution := range executionsList {
-				expectation := oneExecution.Expectation()
-				result := oneExecution.Result()
-				if expectation.IsValid() && result.IsError() {
-					t.Errorf("the token (name: %s) execution (index: %d) was expected to be valid, but contains an error: %s", token.Name(), idx, result.Error())
-					continue
-				}
-
-				if !expectation.IsValid() && result.IsTree() {
-					t.Errorf("the token (name: %s) execution (index: %d) was expected to be invalid, found: %s", token.Name(), idx, result.Tree().Bytes(true))
-					continue
-				}
-			}
-		}
-	}
+	grammartest.AssertCoverages(t, NewGrammar().Grammar(), options)
 }
 
 func TestGrammar_withScript_Success(t *testing.T) {
@@ -0,0 +1,32 @@
+package scripts
+
+import (
+	"github.com/steve-care-software/grammars/domain/trees"
+)
+
+type instruction struct {
+	name  string
+	lines []trees.Position
+}
+
+func createInstruction(
+	name string,
+	lines []trees.Position,
+) Instruction {
+	out := instruction{
+		name:  name,
+		lines: lines,
+	}
+
+	return &out
+}
+
+// Name returns the name
+func (obj *instruction) Name() string {
+	return obj.name
+}
+
+// Lines returns the positions of the lines in the script
+func (obj *instruction) Lines() []trees.Position {
+	return obj.lines
+}
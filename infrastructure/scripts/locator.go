@@ -0,0 +1,48 @@
+package scripts
+
+import (
+	"github.com/steve-care-software/grammars/domain/trees"
+)
+
+type locator struct {
+	compiler *compiler
+}
+
+func createLocator(
+	compiler *compiler,
+) Locator {
+	out := locator{
+		compiler: compiler,
+	}
+
+	return &out
+}
+
+// Locate parses a script and returns the positions of the lines of its instructions, in order
+func (app *locator) Locate(script []byte) ([]Instruction, error) {
+	tree, _, err := app.compiler.compilation(script)
+	if err != nil {
+		return nil, err
+	}
+
+	output := []Instruction{}
+	for _, oneInstruction := range app.compiler.fetch(tree, instructionTokenName) {
+		assignment := app.compiler.children(oneInstruction)[0]
+		name := app.compiler.text(app.compiler.fetch(assignment, variableNameTokenName)[0])
+
+		// the assignments that are not tokens contain a single line, their assigned content:
+		lineTrees := []trees.Tree{app.compiler.children(assignment)[1]}
+		if assignment.Grammar().Name() == tokenAssignmentTokenName {
+			lineTrees = app.compiler.lineTrees(app.compiler.fetch(assignment, blockTokenName)[0])
+		}
+
+		lines := []trees.Position{}
+		for _, oneLineTree := range lineTrees {
+			lines = append(lines, oneLineTree.Position())
+		}
+
+		output = append(output, createInstruction(name, lines))
+	}
+
+	return output, nil
+}
@@ -0,0 +1,69 @@
+package scripts
+
+import (
+	"testing"
+)
+
+func TestLocator_Success(t *testing.T) {
+	script := `@expression;
+-space;
+
+expression: term
+	| term plus term
+;
+
+term: one | two;
+numbers: #plus;
+one: 49;
+two: 50;
+plus: 43;
+space: 32;`
+
+	instructions, err := NewLocator().Locate([]byte(script))
+	if err != nil {
+		t.Errorf("the error was expected to be nil, error returned: %s", err.Error())
+		return
+	}
+
+	expected := map[string][][]uint{
+		"expression": {{4, 4}, {5, 5}},
+		"term":       {{8, 8}, {8, 8}},
+		"numbers":    {{9, 9}},
+		"one":        {{10, 10}},
+	}
+
+	if len(instructions) != 7 {
+		t.Errorf("%d instructions were expected, %d returned", 7, len(instructions))
+		return
+	}
+
+	for _, oneInstruction := range instructions {
+		lines, ok := expected[oneInstruction.Name()]
+		if !ok {
+			continue
+		}
+
+		positions := oneInstruction.Lines()
+		if len(positions) != len(lines) {
+			t.Errorf("the instruction (name: %s) was expected to contain %d lines, %d returned", oneInstruction.Name(), len(lines), len(positions))
+			return
+		}
+
+		for idx, onePosition := range positions {
+			start := onePosition.Start().Line()
+			end := onePosition.End().Line()
+			if start != lines[idx][0] || end != lines[idx][1] {
+				t.Errorf("the line (index: %d) of the instruction (name: %s) was expected to span the lines %d to %d, %d to %d returned", idx, oneInstruction.Name(), lines[idx][0], lines[idx][1], start, end)
+				return
+			}
+		}
+	}
+}
+
+func TestLocator_withInvalidScript_returnsError(t *testing.T) {
+	_, err := NewLocator().Locate([]byte("@expression; expression: one one: 49;"))
+	if err == nil {
+		t.Errorf("the error was expected to be valid, nil returned")
+		return
+	}
+}
@@ -1,13 +1,40 @@
 package scripts
 
 import (
+	"github.com/steve-care-software/grammars/applications"
 	grammars "github.com/steve-care-software/grammars/domain"
 	"github.com/steve-care-software/grammars/domain/references"
+	"github.com/steve-care-software/grammars/domain/trees"
 	"github.com/steve-care-software/grammars/infrastructure/scripts/components"
 	"github.com/steve-care-software/grammars/infrastructure/scripts/tokens"
 )
 
 const grammarTokenName = "grammar"
+const rootTokenName = "root"
+const channelTokenName = "channel"
+const channelPreviousNextInsideTokenName = "channelPreviousNextInside"
+const instructionTokenName = "instruction"
+const valueAssignmentTokenName = "valueAssignment"
+const composeAssignmentTokenName = "composeAssignment"
+const everythingAssignmentTokenName = "everythingAssignment"
+const tokenAssignmentTokenName = "tokenAssignment"
+const composeWithAmountTokenName = "composeWithAmount"
+const everythingWithEscapeTokenName = "everythingWithEscape"
+const blockTokenName = "block"
+const delimiterThenLineTokenName = "delimiterThenLine"
+const lineTokenName = "line"
+const elementTokenName = "element"
+const externalTokenName = "external"
+const cardinalityTokenName = "cardinality"
+const suiteTokenName = "suite"
+const suiteSectionTokenName = "suiteSection"
+const suiteValidTokenName = "suiteValid"
+const suiteInvalidTokenName = "suiteInvalid"
+const suiteBlockTokenName = "suiteBlock"
+const variableNameTokenName = "variableName"
+const numberTokenName = "number"
+const singleLineCommentTokenName = "singleLineComment"
+const newLineTokenName = "newLine"
 
 const assignmentSign = ":"
 const everythingPrefixSign = "#"
@@ -35,7 +62,7 @@ const rootPrefix = "@"
 const rootSuffix = ";"
 const instructionSuffix = ";"
 const externalTokenPrefix = "{"
-const externalTokenSuffix = "{"
+const externalTokenSuffix = "}"
 
 // NewGrammar creates a new grammar instance
 func NewGrammar() Grammar {
@@ -44,16 +71,103 @@ func NewGrammar() Grammar {
 	builder := grammars.NewBuilder()
 	refBuilder := references.NewBuilder()
 	refTokensBuilder := references.NewTokensBuilder()
+	refGrammarsBuilder := references.NewGrammarsBuilder()
+	refGrammarBuilder := references.NewGrammarBuilder()
 	return createGrammar(
 		component,
 		token,
 		builder,
 		refBuilder,
 		refTokensBuilder,
+		refGrammarsBuilder,
+		refGrammarBuilder,
 	)
 }
 
+// NewCompiler creates a new compiler instance
+func NewCompiler() Compiler {
+	return newCompiler()
+}
+
+func newCompiler() *compiler {
+	application := applications.NewApplication()
+	grammar := NewGrammar().Grammar()
+	builder := grammars.NewBuilder()
+	channelBuilder := grammars.NewChannelBuilder()
+	channelConditionBuilder := grammars.NewChannelConditionBuilder()
+	tokenBuilder := grammars.NewTokenBuilder()
+	suiteBuilder := grammars.NewSuiteBuilder()
+	lineBuilder := grammars.NewLineBuilder()
+	elementBuilder := grammars.NewElementBuilder()
+	instanceBuilder := grammars.NewInstanceBuilder()
+	everythingBuilder := grammars.NewEverythingBuilder()
+	cardinalityBuilder := grammars.NewCardinalityBuilder()
+	refBuilder := references.NewBuilder()
+	refTokensBuilder := references.NewTokensBuilder()
+	refTokenBuilder := references.NewTokenBuilder()
+	return createCompiler(
+		application,
+		grammar,
+		builder,
+		channelBuilder,
+		channelConditionBuilder,
+		tokenBuilder,
+		suiteBuilder,
+		lineBuilder,
+		elementBuilder,
+		instanceBuilder,
+		everythingBuilder,
+		cardinalityBuilder,
+		refBuilder,
+		refTokensBuilder,
+		refTokenBuilder,
+	)
+}
+
+// NewDecompiler creates a new decompiler instance
+func NewDecompiler() Decompiler {
+	return createDecompiler()
+}
+
+// NewFormatter creates a new formatter instance
+func NewFormatter() Formatter {
+	compiler := newCompiler()
+	return createFormatter(compiler)
+}
+
+// NewLocator creates a new locator instance
+func NewLocator() Locator {
+	compiler := newCompiler()
+	return createLocator(compiler)
+}
+
 // Grammar represents the grammar
 type Grammar interface {
 	Grammar() references.Reference
 }
+
+// Compiler represents a grammar script compiler
+type Compiler interface {
+	Compile(script []byte) (references.Reference, error)
+}
+
+// Formatter represents a grammar script formatter
+type Formatter interface {
+	Format(script []byte) ([]byte, error)
+}
+
+// Locator represents a grammar script locator
+type Locator interface {
+	Locate(script []byte) ([]Instruction, error)
+}
+
+// Instruction represents the lines of an instruction in a grammar script
+type Instruction interface {
+	Name() string
+	Lines() []trees.Position
+}
+
+// Decompiler represents a grammar reference decompiler
+type Decompiler interface {
+	Decompile(reference references.Reference) ([]byte, error)
+}
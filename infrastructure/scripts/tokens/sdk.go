@@ -18,6 +18,7 @@ func NewToken() Token {
 // Token represents the token component
 type Token interface {
 	VariableName() (references.Token, []references.Token)
+	Number() (references.Token, []references.Token)
 	Sha512Hex() (references.Token, []references.Token)
 	AnyHexCharacter() (references.Token, []references.Token)
 	AnyLetter() (references.Token, []references.Token)
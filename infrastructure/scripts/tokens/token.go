@@ -49,6 +49,27 @@ func (app *token) VariableName() (references.Token, []references.Token) {
 	), output
 }
 
+// Number returns the unsigned number token
+func (app *token) Number() (references.Token, []references.Token) {
+	nameAnyNumber := app.AnyNumber()
+	return app.component.Token().FromLines(
+			"number",
+			[]grammars.Line{
+				app.component.Line().FromElements([]grammars.Element{
+					app.component.Element().FromToken(nameAnyNumber.Reference(), app.component.Cardinality().Cardinality(1, nil)),
+				}),
+			},
+			app.component.Suite().Suites(map[string]bool{
+				"0":   true,
+				"45":  true,
+				"255": true,
+				"a":   false,
+			}),
+		), []references.Token{
+			nameAnyNumber,
+		}
+}
+
 // Sha512Hex returns the sha512 token
 func (app *token) Sha512Hex() (references.Token, []references.Token) {
 	amount := uint(128)